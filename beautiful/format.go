@@ -0,0 +1,314 @@
+package beautiful
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	FormatJSON        = "json"
+	FormatJSONCompact = "json-compact"
+	FormatJSONLines   = "jsonl"
+	FormatYAML        = "yaml"
+	FormatTable       = "table"
+	FormatCSV         = "csv"
+	FormatTSV         = "tsv"
+)
+
+// Formatter escreve os dados de um comando em um formato específico
+type Formatter interface {
+	Format(w io.Writer, data any, out *Output) error
+}
+
+// FormatterFunc permite usar funções simples como Formatter
+type FormatterFunc func(w io.Writer, data any, out *Output) error
+
+func (f FormatterFunc) Format(w io.Writer, data any, out *Output) error {
+	return f(w, data, out)
+}
+
+var (
+	formattersMu  sync.RWMutex
+	formatters    = map[string]Formatter{}
+	defaultFormat = FormatJSON
+)
+
+func init() {
+	RegisterFormatter(FormatJSON, FormatterFunc(formatJSON))
+	RegisterFormatter(FormatJSONCompact, FormatterFunc(formatJSONCompact))
+	RegisterFormatter(FormatJSONLines, FormatterFunc(formatJSONLines))
+	RegisterFormatter(FormatYAML, FormatterFunc(formatYAML))
+	RegisterFormatter(FormatTable, FormatterFunc(formatTable))
+	RegisterFormatter(FormatCSV, FormatterFunc(formatSeparated(',')))
+	RegisterFormatter(FormatTSV, FormatterFunc(formatSeparated('\t')))
+}
+
+// RegisterFormatter registra (ou substitui) um formatter pelo nome
+func RegisterFormatter(name string, formatter Formatter) {
+	formattersMu.Lock()
+	defer formattersMu.Unlock()
+	formatters[strings.ToLower(name)] = formatter
+}
+
+// GetFormatter retorna o formatter registrado com o nome informado
+func GetFormatter(name string) (Formatter, error) {
+	formattersMu.RLock()
+	defer formattersMu.RUnlock()
+	formatter, ok := formatters[strings.ToLower(name)]
+	if !ok {
+		return nil, fmt.Errorf("unknown output format %q, available: %s", name, strings.Join(formatNamesLocked(), ", "))
+	}
+	return formatter, nil
+}
+
+// FormatNames lista os formatos disponíveis em ordem alfabética
+func FormatNames() []string {
+	formattersMu.RLock()
+	defer formattersMu.RUnlock()
+	return formatNamesLocked()
+}
+
+func formatNamesLocked() []string {
+	names := make([]string, 0, len(formatters))
+	for name := range formatters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SetDefaultFormat define o formato usado pelas saídas criadas com NewOutput
+func SetDefaultFormat(name string) error {
+	if _, err := GetFormatter(name); err != nil {
+		return err
+	}
+	formattersMu.Lock()
+	defer formattersMu.Unlock()
+	defaultFormat = strings.ToLower(name)
+	return nil
+}
+
+// DefaultFormat retorna o formato padrão atual
+func DefaultFormat() string {
+	formattersMu.RLock()
+	defer formattersMu.RUnlock()
+	return defaultFormat
+}
+
+func formatJSON(w io.Writer, data any, out *Output) error {
+	jsonData, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return err
+	}
+	if out.rawMode {
+		_, err = fmt.Fprintln(w, string(jsonData))
+		return err
+	}
+	_, err = fmt.Fprintln(w, out.colorizeJSON(string(jsonData)))
+	return err
+}
+
+func formatJSONCompact(w io.Writer, data any, _ *Output) error {
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(jsonData))
+	return err
+}
+
+func formatJSONLines(w io.Writer, data any, _ *Output) error {
+	generic, err := toGeneric(data)
+	if err != nil {
+		return err
+	}
+
+	items, ok := listItems(generic)
+	if !ok {
+		items = []any{generic}
+	}
+
+	encoder := json.NewEncoder(w)
+	for _, item := range items {
+		if err := encoder.Encode(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func formatYAML(w io.Writer, data any, _ *Output) error {
+	generic, err := toGeneric(data)
+	if err != nil {
+		return err
+	}
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(generic); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+func formatTable(w io.Writer, data any, out *Output) error {
+	headers, rows, err := tabular(data)
+	if err != nil {
+		return err
+	}
+	out.writeTable(w, headers, rows)
+	return nil
+}
+
+func formatSeparated(separator rune) FormatterFunc {
+	return func(w io.Writer, data any, _ *Output) error {
+		headers, rows, err := tabular(data)
+		if err != nil {
+			return err
+		}
+
+		writer := csv.NewWriter(w)
+		writer.Comma = separator
+		if err := writer.Write(headers); err != nil {
+			return err
+		}
+		if err := writer.WriteAll(rows); err != nil {
+			return err
+		}
+		writer.Flush()
+		return writer.Error()
+	}
+}
+
+// toGeneric converte qualquer valor para a representação genérica do JSON
+// (map[string]any, []any, string, float64, bool, nil) respeitando as tags json
+func toGeneric(data any) (any, error) {
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+
+	var generic any
+	if err := decoder.Decode(&generic); err != nil {
+		return nil, err
+	}
+	return normalizeNumbers(generic), nil
+}
+
+// normalizeNumbers troca json.Number por int64 ou float64 para que inteiros
+// grandes não sejam exibidos em notação científica
+func normalizeNumbers(value any) any {
+	switch v := value.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	case map[string]any:
+		for key, item := range v {
+			v[key] = normalizeNumbers(item)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = normalizeNumbers(item)
+		}
+	}
+	return value
+}
+
+// listItems identifica a lista dentro de uma resposta. Aceita tanto um array
+// direto quanto um objeto que contém um único campo do tipo array
+// (ex: {"instances": [...], "meta": {...}})
+func listItems(generic any) ([]any, bool) {
+	switch v := generic.(type) {
+	case []any:
+		return v, true
+	case map[string]any:
+		var found []any
+		count := 0
+		for _, value := range v {
+			if items, ok := value.([]any); ok {
+				found = items
+				count++
+			}
+		}
+		if count == 1 {
+			return found, true
+		}
+	}
+	return nil, false
+}
+
+func collectHeaders(items []any) []string {
+	seen := map[string]bool{}
+	headers := []string{}
+	for _, item := range items {
+		obj, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		for key := range obj {
+			if !seen[key] {
+				seen[key] = true
+				headers = append(headers, key)
+			}
+		}
+	}
+
+	if len(headers) == 0 {
+		return []string{"value"}
+	}
+
+	sort.SliceStable(headers, func(i, j int) bool {
+		pi, pj := headerPriority(headers[i]), headerPriority(headers[j])
+		if pi != pj {
+			return pi < pj
+		}
+		return headers[i] < headers[j]
+	})
+	return headers
+}
+
+func headerPriority(header string) int {
+	switch header {
+	case "id":
+		return 0
+	case "name":
+		return 1
+	}
+	return 2
+}
+
+func cellString(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		jsonData, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		return string(jsonData)
+	}
+}
@@ -3,6 +3,7 @@ package beautiful
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
@@ -11,6 +12,8 @@ import (
 
 type Output struct {
 	rawMode bool
+	format  string
+	writer  io.Writer
 	data    interface{}
 }
 
@@ -18,27 +21,48 @@ func NewOutput(rawMode bool) *Output {
 
 	return &Output{
 		rawMode: rawMode,
+		format:  DefaultFormat(),
+		writer:  os.Stdout,
 	}
 }
 
+// WithFormat sobrescreve o formato padrão para esta saída
+func (bo *Output) WithFormat(format string) *Output {
+	bo.format = format
+	return bo
+}
+
+// WithWriter redireciona a saída de dados (padrão: os.Stdout)
+func (bo *Output) WithWriter(w io.Writer) *Output {
+	bo.writer = w
+	return bo
+}
+
+func (bo *Output) Format() string {
+	return bo.format
+}
+
 func (bo *Output) PrintData(data interface{}) {
+	data, queried, err := applyQuery(data)
+	if err != nil {
+		setPendingError(err)
+		return
+	}
 	bo.data = data
 
-	if bo.rawMode {
-		jsonData, err := json.MarshalIndent(data, "", "  ")
-		if err != nil {
+	// Resultados escalares de uma query são impressos sem aspas no modo raw
+	if queried && bo.rawMode && !IsTabularFormat(bo.format) {
+		if str, ok := data.(string); ok {
+			fmt.Fprintln(bo.writer, str)
 			return
 		}
-		fmt.Println(string(jsonData))
-		return
-	}
-	jsonData, err := json.MarshalIndent(data, "", "  ")
-	if err != nil {
-		return
 	}
 
-	expFlag := os.Getenv("EXPLORE_JSON") == "1"
-	if expFlag {
+	if bo.format == FormatJSON && !bo.rawMode && os.Getenv("EXPLORE_JSON") == "1" {
+		jsonData, err := json.MarshalIndent(data, "", "  ")
+		if err != nil {
+			return
+		}
 		explorer := NewJSONExplorer(bo)
 		if err := explorer.ExploreJSON(jsonData); err != nil {
 			fmt.Println(err)
@@ -47,8 +71,15 @@ func (bo *Output) PrintData(data interface{}) {
 		return
 	}
 
-	coloredJSON := bo.colorizeJSON(string(jsonData))
-	fmt.Println(coloredJSON)
+	formatter, err := GetFormatter(bo.format)
+	if err != nil {
+		setPendingError(err)
+		return
+	}
+
+	if err := formatter.Format(bo.writer, data, bo); err != nil {
+		setPendingError(err)
+	}
 }
 
 func (bo *Output) PrintJSON(data interface{}) error {
@@ -88,7 +119,17 @@ func (bo *Output) PrintError(message string) {
 	}
 
 	errorColor := color.New(color.FgRed, color.Bold)
-	errorColor.Printf("Error: %s\n", message)
+	errorColor.Fprintf(os.Stderr, "Error: %s\n", message)
+}
+
+// PrintErrorData escreve um erro estruturado em stderr, uma linha JSON por erro
+func (bo *Output) PrintErrorData(data any) {
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		bo.PrintError(err.Error())
+		return
+	}
+	fmt.Fprintln(os.Stderr, string(jsonData))
 }
 
 func (bo *Output) PrintWarning(message string) {
@@ -112,10 +153,14 @@ func (bo *Output) PrintInfo(message string) {
 }
 
 func (bo *Output) PrintTable(headers []string, rows [][]string) {
+	bo.writeTable(bo.writer, headers, rows)
+}
+
+func (bo *Output) writeTable(w io.Writer, headers []string, rows [][]string) {
 	if bo.rawMode {
-		fmt.Println(strings.Join(headers, "\t"))
+		fmt.Fprintln(w, strings.Join(headers, "\t"))
 		for _, row := range rows {
-			fmt.Println(strings.Join(row, "\t"))
+			fmt.Fprintln(w, strings.Join(row, "\t"))
 		}
 		return
 	}
@@ -135,54 +180,54 @@ func (bo *Output) PrintTable(headers []string, rows [][]string) {
 		}
 	}
 
-	headerColor.Print("┌")
+	headerColor.Fprint(w, "┌")
 	for i, width := range widths {
 		for j := 0; j < width+2; j++ {
-			headerColor.Print("─")
+			headerColor.Fprint(w, "─")
 		}
 		if i < len(widths)-1 {
-			headerColor.Print("┬")
+			headerColor.Fprint(w, "┬")
 		}
 	}
-	headerColor.Println("┐")
+	headerColor.Fprintln(w, "┐")
 
-	headerColor.Print("│")
+	headerColor.Fprint(w, "│")
 	for i, header := range headers {
-		headerColor.Printf(" %-*s │", widths[i], header)
+		headerColor.Fprintf(w, " %-*s │", widths[i], header)
 	}
-	headerColor.Println()
+	headerColor.Fprintln(w)
 
-	headerColor.Print("├")
+	headerColor.Fprint(w, "├")
 	for i, width := range widths {
 		for j := 0; j < width+2; j++ {
-			headerColor.Print("─")
+			headerColor.Fprint(w, "─")
 		}
 		if i < len(widths)-1 {
-			headerColor.Print("┼")
+			headerColor.Fprint(w, "┼")
 		}
 	}
-	headerColor.Println("┤")
+	headerColor.Fprintln(w, "┤")
 
 	for _, row := range rows {
-		rowColor.Print("│")
+		rowColor.Fprint(w, "│")
 		for i, cell := range row {
 			if i < len(widths) {
-				rowColor.Printf(" %-*s │", widths[i], cell)
+				rowColor.Fprintf(w, " %-*s │", widths[i], cell)
 			}
 		}
-		rowColor.Println()
+		rowColor.Fprintln(w)
 	}
 
-	headerColor.Print("└")
+	headerColor.Fprint(w, "└")
 	for i, width := range widths {
 		for j := 0; j < width+2; j++ {
-			headerColor.Print("─")
+			headerColor.Fprint(w, "─")
 		}
 		if i < len(widths)-1 {
-			headerColor.Print("┴")
+			headerColor.Fprint(w, "┴")
 		}
 	}
-	headerColor.Println("┘")
+	headerColor.Fprintln(w, "┘")
 }
 
 func (bo *Output) PrintList(title string, items []string) {
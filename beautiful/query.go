@@ -0,0 +1,98 @@
+package beautiful
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/jmespath/go-jmespath"
+)
+
+var (
+	queryMu      sync.RWMutex
+	query        *jmespath.JMESPath
+	pendingError error
+)
+
+// SetQuery compila a expressão JMESPath (flag --query) aplicada a todos os
+// dados antes da formatação. Uma expressão vazia desativa o filtro
+func SetQuery(expression string) error {
+	queryMu.Lock()
+	defer queryMu.Unlock()
+
+	expression = strings.TrimSpace(expression)
+	if expression == "" {
+		query = nil
+		return nil
+	}
+
+	compiled, err := jmespath.Compile(expression)
+	if err != nil {
+		return fmt.Errorf("invalid query %q: %w", expression, err)
+	}
+	query = compiled
+	return nil
+}
+
+// HasQuery informa se há uma expressão --query ativa
+func HasQuery() bool {
+	queryMu.RLock()
+	defer queryMu.RUnlock()
+	return query != nil
+}
+
+// PendingError retorna (e limpa) o primeiro erro ocorrido ao imprimir dados,
+// para que o comando possa reportá-lo e encerrar com falha
+func PendingError() error {
+	queryMu.Lock()
+	defer queryMu.Unlock()
+	err := pendingError
+	pendingError = nil
+	return err
+}
+
+func setPendingError(err error) {
+	queryMu.Lock()
+	defer queryMu.Unlock()
+	if pendingError == nil {
+		pendingError = err
+	}
+}
+
+func applyQuery(data any) (any, bool, error) {
+	queryMu.RLock()
+	compiled := query
+	queryMu.RUnlock()
+
+	if compiled == nil {
+		return data, false, nil
+	}
+
+	input, err := toQueryInput(data)
+	if err != nil {
+		return nil, true, err
+	}
+
+	result, err := compiled.Search(input)
+	if err != nil {
+		return nil, true, fmt.Errorf("query failed: %w", err)
+	}
+	return result, true, nil
+}
+
+// toQueryInput converte os dados para a representação do JSON com números
+// float64, o único tipo numérico reconhecido pelo go-jmespath em filtros,
+// sort_by e funções como sum. A troca por int64 fica para a formatação
+func toQueryInput(data any) (any, error) {
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var input any
+	if err := json.Unmarshal(jsonData, &input); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
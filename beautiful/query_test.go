@@ -0,0 +1,78 @@
+package beautiful
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type queryVolume struct {
+	Name string `json:"name"`
+	Size int    `json:"size"`
+}
+
+func TestApplyQuery(t *testing.T) {
+	data := map[string]any{
+		"volumes": []queryVolume{
+			{Name: "c", Size: 30},
+			{Name: "a", Size: 5},
+			{Name: "b", Size: 20},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		expression string
+		expected   string
+	}{
+		{name: "filter", expression: "volumes[?size > `10`].name", expected: `["c","b"]`},
+		{name: "filter equal", expression: "volumes[?size == `5`].name", expected: `["a"]`},
+		{name: "sort_by", expression: "sort_by(volumes, &size)[].name", expected: `["a","b","c"]`},
+		{name: "max_by", expression: "max_by(volumes, &size).name", expected: `"c"`},
+		{name: "sum", expression: "sum(volumes[].size)", expected: `55`},
+		{name: "avg", expression: "avg(volumes[].size)", expected: `18.333333333333332`},
+		{name: "length", expression: "length(volumes)", expected: `3`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := SetQuery(tt.expression); err != nil {
+				t.Fatal(err)
+			}
+			defer SetQuery("")
+
+			result, queried, err := applyQuery(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !queried {
+				t.Fatal("query was not applied")
+			}
+			got, err := json.Marshal(result)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.expected {
+				t.Errorf("got %s, want %s", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestApplyQueryKeepsLargeIntegersForDisplay(t *testing.T) {
+	if err := SetQuery("id"); err != nil {
+		t.Fatal(err)
+	}
+	defer SetQuery("")
+
+	result, _, err := applyQuery(map[string]any{"id": int64(1234567890123)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	generic, err := toGeneric(result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if generic != int64(1234567890123) {
+		t.Errorf("got %#v, want int64(1234567890123)", generic)
+	}
+}
@@ -0,0 +1,287 @@
+package beautiful
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var (
+	columnsMu      sync.RWMutex
+	defaultColumns = map[reflect.Type][]string{}
+	userColumns    []string
+
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// RegisterDefaultColumns define as colunas exibidas por padrão quando uma
+// lista de T (ou um único T) é renderizada como tabela/csv/tsv.
+// Colunas aceitam caminhos com ponto para campos aninhados (ex: machine_type.name)
+func RegisterDefaultColumns[T any](columns ...string) {
+	columnsMu.Lock()
+	defer columnsMu.Unlock()
+	defaultColumns[reflect.TypeOf((*T)(nil)).Elem()] = columns
+}
+
+// SetColumns define as colunas escolhidas pelo usuário (flag --columns),
+// que têm precedência sobre as colunas padrão do recurso
+func SetColumns(columns []string) {
+	columnsMu.Lock()
+	defer columnsMu.Unlock()
+	userColumns = nil
+	for _, column := range columns {
+		if column = strings.TrimSpace(column); column != "" {
+			userColumns = append(userColumns, column)
+		}
+	}
+}
+
+// Columns retorna as colunas escolhidas pelo usuário
+func Columns() []string {
+	columnsMu.RLock()
+	defer columnsMu.RUnlock()
+	return userColumns
+}
+
+// Table é uma lista genérica de itens com as colunas usadas ao renderizá-la
+// como tabela/csv/tsv, para listas montadas pela CLI que não têm um tipo Go
+// de onde derivar as colunas. Em JSON é serializada como a lista de itens
+type Table struct {
+	Items   []any
+	Columns []string
+}
+
+func (t Table) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Items)
+}
+
+// IsTabularFormat informa se o formato é renderizado em linhas e colunas
+func IsTabularFormat(format string) bool {
+	switch format {
+	case FormatTable, FormatCSV, FormatTSV:
+		return true
+	}
+	return false
+}
+
+// IsJSONFormat informa se o formato é consumido como JSON por outras ferramentas
+func IsJSONFormat(format string) bool {
+	switch format {
+	case FormatJSON, FormatJSONCompact, FormatJSONLines:
+		return true
+	}
+	return false
+}
+
+func tabular(data any) ([]string, [][]string, error) {
+	generic, err := toGeneric(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	items, ok := listItems(generic)
+	if !ok {
+		items = []any{generic}
+	}
+
+	headers := Columns()
+	if table, ok := data.(Table); ok && len(headers) == 0 {
+		headers = table.Columns
+	}
+	if len(headers) == 0 {
+		headers = reflectColumns(data)
+	}
+	if len(headers) == 0 {
+		headers = collectHeaders(items)
+	}
+
+	rows := make([][]string, 0, len(items))
+	for _, item := range items {
+		row := make([]string, len(headers))
+		for i, header := range headers {
+			if _, isObject := item.(map[string]any); !isObject && header == "value" {
+				row[i] = cellString(item)
+				continue
+			}
+			row[i] = cellString(lookupPath(item, header))
+		}
+		rows = append(rows, row)
+	}
+
+	return headers, rows, nil
+}
+
+// lookupPath navega por um valor genérico usando um caminho separado por
+// pontos. Índices numéricos acessam elementos de arrays (ex: ports.0.id)
+func lookupPath(value any, path string) any {
+	current := value
+	for _, part := range strings.Split(path, ".") {
+		switch v := current.(type) {
+		case map[string]any:
+			current = v[part]
+		case []any:
+			index, err := strconv.Atoi(part)
+			if err != nil || index < 0 || index >= len(v) {
+				return nil
+			}
+			current = v[index]
+		default:
+			return nil
+		}
+	}
+	return current
+}
+
+// reflectColumns deriva as colunas a partir do tipo Go dos dados: se for uma
+// resposta de listagem, usa o tipo dos itens da lista
+func reflectColumns(data any) []string {
+	if data == nil {
+		return nil
+	}
+
+	rowType := listElemType(derefType(reflect.TypeOf(data)))
+	if rowType == nil || rowType.Kind() != reflect.Struct {
+		return nil
+	}
+
+	columnsMu.RLock()
+	columns, ok := defaultColumns[rowType]
+	columnsMu.RUnlock()
+	if ok {
+		return columns
+	}
+
+	return structColumns(rowType, "")
+}
+
+func listElemType(t reflect.Type) reflect.Type {
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array:
+		return derefType(t.Elem())
+	case reflect.Struct:
+		var found reflect.Type
+		count := 0
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			fieldType := derefType(field.Type)
+			if fieldType.Kind() == reflect.Slice && derefType(fieldType.Elem()).Kind() == reflect.Struct {
+				found = derefType(fieldType.Elem())
+				count++
+			}
+		}
+		if count == 1 {
+			return found
+		}
+	}
+	return t
+}
+
+func structColumns(t reflect.Type, prefix string) []string {
+	columns := []string{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name := jsonFieldName(field)
+		if name == "-" {
+			continue
+		}
+
+		fieldType := derefType(field.Type)
+		if field.Anonymous && fieldType.Kind() == reflect.Struct && !isScalarType(fieldType) {
+			columns = append(columns, structColumns(fieldType, prefix)...)
+			continue
+		}
+
+		if isScalarType(fieldType) {
+			columns = append(columns, prefix+name)
+			continue
+		}
+
+		// Structs aninhados aparecem pelo nome (ou id) para não poluir a tabela
+		if fieldType.Kind() == reflect.Struct && prefix == "" {
+			for _, sub := range []string{"name", "id"} {
+				if hasScalarField(fieldType, sub) {
+					columns = append(columns, name+"."+sub)
+					break
+				}
+			}
+		}
+	}
+	return columns
+}
+
+func hasScalarField(t reflect.Type, name string) bool {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.IsExported() && jsonFieldName(field) == name && isScalarType(derefType(field.Type)) {
+			return true
+		}
+	}
+	return false
+}
+
+func jsonFieldName(field reflect.StructField) string {
+	tag := field.Tag.Get("json")
+	name := strings.Split(tag, ",")[0]
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
+
+func isScalarType(t reflect.Type) bool {
+	if t.Implements(jsonMarshalerType) || reflect.PointerTo(t).Implements(jsonMarshalerType) {
+		return true
+	}
+	if t.Implements(textMarshalerType) || reflect.PointerTo(t).Implements(textMarshalerType) {
+		return true
+	}
+
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func derefType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+// ListItems converte uma resposta de listagem para a lista genérica de itens.
+// Valores que não são listas viram uma lista de um único item
+func ListItems(data any) ([]any, error) {
+	generic, err := toGeneric(data)
+	if err != nil {
+		return nil, err
+	}
+	if items, ok := listItems(generic); ok {
+		return items, nil
+	}
+	return []any{generic}, nil
+}
+
+// ColumnsOf retorna as colunas usadas ao renderizar data como tabela,
+// sem considerar as chaves genéricas de respostas sem tipo conhecido
+func ColumnsOf(data any) []string {
+	if columns := Columns(); len(columns) > 0 {
+		return columns
+	}
+	return reflectColumns(data)
+}
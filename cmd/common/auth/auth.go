@@ -8,11 +8,14 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
-	"github.com/magaluCloud/mgccli/cmd/common/structs"
+	"github.com/magaluCloud/mgccli/cmd/common/filelock"
 	"github.com/magaluCloud/mgccli/cmd/common/workspace"
-	"gopkg.in/yaml.v3"
 )
 
+// tokenRefreshWindow antecipa a renovação do access token para que ele não
+// expire durante a execução do comando
+const tokenRefreshWindow = 2 * time.Minute
+
 // access_key_id: ""
 // access_token: ""
 // current_environment: ""
@@ -41,26 +44,41 @@ type Auth interface {
 
 	ValidateToken() error
 	RefreshToken(ctx context.Context) error
+	Logout() error
 }
 
 type authValue struct {
 	authValue AuthFile
 	workspace workspace.Workspace
 	service   *Service
+	store     CredentialStore
+	loaded    bool
+	// loadErr impede que credenciais que não puderam ser lidas (ex: senha
+	// errada) sejam sobrescritas
+	loadErr error
 }
 
-func NewAuth(workspace workspace.Workspace) Auth {
-	authFile := path.Join(workspace.Dir(), "auth.yaml")
-	authContent, err := structs.LoadFileToStruct[AuthFile](authFile)
-
-	config := DefaultConfig()
+// NewAuth não lê as credenciais: elas só são carregadas quando um comando as
+// usa, para que --help e completions não peçam a senha do store cifrado
+func NewAuth(workspace workspace.Workspace, env string, store CredentialStore) Auth {
+	config := ConfigForEnv(env)
 	service := NewService(config)
+	return &authValue{workspace: workspace, service: service, store: store}
+}
+
+// load lê as credenciais do store na primeira vez em que são usadas
+func (a *authValue) load() {
+	if a.loaded {
+		return
+	}
+	a.loaded = true
 
+	content, err := a.store.Load()
 	if err != nil {
-		//TODO: Handle error
-		panic(err)
+		fmt.Fprintf(os.Stderr, "Warning: could not read the saved credentials: %s\n", err)
 	}
-	return &authValue{workspace: workspace, authValue: authContent, service: service}
+	a.authValue = content
+	a.loadErr = err
 }
 
 func (a *authValue) GetService() *Service {
@@ -68,75 +86,124 @@ func (a *authValue) GetService() *Service {
 }
 
 func (a *authValue) GetAccessKeyID() string {
+	a.load()
 	return a.authValue.AccessKeyID
 }
 
+// GetAccessToken retorna o access token, renovando-o quando expira dentro
+// de tokenRefreshWindow
 func (a *authValue) GetAccessToken(ctx context.Context) string {
+	a.load()
 	if a.authValue.AccessToken == "" {
 		return ""
 	}
-	err := a.ValidateToken()
-	if err != nil {
-		if a.authValue.RefreshToken != "" {
-			err := a.RefreshToken(ctx)
-			if err != nil {
-				return ""
-			}
-			return a.authValue.AccessToken
+	if a.validateToken(tokenRefreshWindow) == nil || a.authValue.RefreshToken == "" {
+		return a.authValue.AccessToken
+	}
+
+	err := a.update(func() error {
+		// outro processo pode ter renovado o token enquanto este esperava o lock
+		if a.validateToken(tokenRefreshWindow) == nil {
+			return nil
 		}
+		return a.refresh(ctx)
+	})
+	if err != nil {
+		return ""
 	}
 	return a.authValue.AccessToken
 }
 
 func (a *authValue) GetRefreshToken() string {
+	a.load()
 	return a.authValue.RefreshToken
 }
 
 func (a *authValue) GetSecretAccessKey() string {
+	a.load()
 	return a.authValue.SecretAccessKey
 }
 
 func (a *authValue) SetAccessToken(token string) error {
-	a.authValue.AccessToken = token
-	return a.Write()
+	return a.update(func() error {
+		a.authValue.AccessToken = token
+		return nil
+	})
 }
 
 func (a *authValue) SetRefreshToken(token string) error {
-	a.authValue.RefreshToken = token
-	return a.Write()
+	return a.update(func() error {
+		a.authValue.RefreshToken = token
+		return nil
+	})
 }
 
 func (a *authValue) SetSecretAccessKey(key string) error {
-	a.authValue.SecretAccessKey = key
-	return a.Write()
+	return a.update(func() error {
+		a.authValue.SecretAccessKey = key
+		return nil
+	})
 }
 
 func (a *authValue) SetAccessKeyID(key string) error {
-	a.authValue.AccessKeyID = key
-	return a.Write()
+	return a.update(func() error {
+		a.authValue.AccessKeyID = key
+		return nil
+	})
 }
 
-func (a *authValue) Logout(name string) error {
-	a.SetAccessToken("")
-	a.SetRefreshToken("")
-	a.SetSecretAccessKey("")
-	a.SetAccessKeyID("")
-	return a.Write()
+// Logout remove as credenciais salvas no workspace
+func (a *authValue) Logout() error {
+	lock, err := filelock.AcquireWithTimeout(a.lockPath())
+	if err != nil {
+		return err
+	}
+	defer lock.Release()
+
+	a.authValue = AuthFile{}
+	a.loaded = true
+	a.loadErr = nil
+	return a.store.Delete()
 }
 
-func (a *authValue) Write() error {
-	data, err := yaml.Marshal(a.authValue)
+// update relê as credenciais com o lock entre processos obtido, aplica change
+// e grava o resultado, para não descartar o que outros processos gravaram
+// (ex: um refresh token renovado em paralelo)
+func (a *authValue) update(change func() error) error {
+	a.load()
+	if a.loadErr != nil {
+		return fmt.Errorf("saved credentials could not be read and will not be overwritten: %w", a.loadErr)
+	}
+
+	lock, err := filelock.AcquireWithTimeout(a.lockPath())
 	if err != nil {
 		return err
 	}
-	err = os.WriteFile(path.Join(a.workspace.Dir(), "auth.yaml"), data, 0644)
+	defer lock.Release()
+
+	content, err := a.store.Load()
 	if err != nil {
 		return err
 	}
-	return nil
+	a.authValue = content
+
+	if err := change(); err != nil {
+		return err
+	}
+	if a.authValue == content {
+		return nil
+	}
+	return a.store.Save(a.authValue)
+}
+
+// lockPath é o mesmo para todos os backends, já que o lock protege o
+// workspace e não um arquivo específico
+func (a *authValue) lockPath() string {
+	return path.Join(a.workspace.Dir(), authFileName)
 }
 
 func (a *authValue) TokenClaims() (*TokenClaims, error) {
+	a.load()
 	if a.authValue.AccessToken == "" {
 		return nil, fmt.Errorf("access token is not set")
 	}
@@ -152,25 +219,42 @@ func (a *authValue) TokenClaims() (*TokenClaims, error) {
 	return tokenClaims, nil
 }
 
+// ValidateToken verifica se o access token ainda não expirou
 func (a *authValue) ValidateToken() error {
-	//extract iat from token, if expires in less than 30 sec, run refresh operation
+	return a.validateToken(0)
+}
+
+// validateToken falha também quando o token expira em menos de margin
+func (a *authValue) validateToken(margin time.Duration) error {
 	tokenClaims, err := a.TokenClaims()
 	if err != nil {
 		return err
 	}
-	iat := tokenClaims.ExpiresAt.Time.Unix()
-	if iat < time.Now().Unix()-60 {
+	if tokenClaims.ExpiresAt == nil {
+		return nil
+	}
+	if time.Until(tokenClaims.ExpiresAt.Time) <= margin {
 		return fmt.Errorf("token expired")
 	}
 	return nil
 }
 
+// RefreshToken renova o access token mesmo que ainda seja válido
 func (a *authValue) RefreshToken(ctx context.Context) error {
+	return a.update(func() error {
+		return a.refresh(ctx)
+	})
+}
+
+func (a *authValue) refresh(ctx context.Context) error {
 	token, err := a.service.RefreshToken(ctx, a.authValue.RefreshToken)
 	if err != nil {
 		return err
 	}
 	a.authValue.AccessToken = token.AccessToken
-	a.authValue.RefreshToken = token.RefreshToken
-	return a.Write()
+	// o servidor pode não rotacionar o refresh token
+	if token.RefreshToken != "" {
+		a.authValue.RefreshToken = token.RefreshToken
+	}
+	return nil
 }
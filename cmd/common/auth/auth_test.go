@@ -0,0 +1,171 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/magaluCloud/mgccli/cmd/common/workspace"
+)
+
+// newToken cria um access token que expira em expiresIn
+func newToken(t *testing.T, expiresIn time.Duration) string {
+	t.Helper()
+	claims := TokenClaims{RegisteredClaims: jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(time.Now().Add(expiresIn))}}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+// fakeRefreshServer rotaciona o refresh token a cada renovação e, como o
+// provedor real, recusa refresh tokens já usados
+type fakeRefreshServer struct {
+	t       *testing.T
+	mu      sync.Mutex
+	current string
+	count   int
+}
+
+func (f *fakeRefreshServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := r.ParseForm(); err != nil || r.Form.Get("grant_type") != "refresh_token" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if r.Form.Get("refresh_token") != f.current {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(oauthError{Error: "invalid_grant"})
+		return
+	}
+	f.count++
+	f.current = fmt.Sprintf("refresh-%d", f.count)
+	json.NewEncoder(w).Encode(TokenResponse{AccessToken: newToken(f.t, time.Hour), RefreshToken: f.current})
+}
+
+// newTestAuth cria um Auth que lê as credenciais do workspace como outro
+// processo da CLI faria
+func newTestAuth(ws workspace.Workspace, tokenURL string) *authValue {
+	config := DefaultConfig()
+	config.TokenURL = tokenURL
+	return &authValue{workspace: ws, service: NewService(config), store: NewFileStore(ws.Dir())}
+}
+
+func TestGetAccessTokenRefreshesOnce(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	ws := workspace.NewWorkspace().Current()
+
+	fake := &fakeRefreshServer{t: t, current: "refresh-0"}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	expired := newToken(t, -time.Minute)
+	if err := NewFileStore(ws.Dir()).Save(AuthFile{AccessToken: expired, RefreshToken: "refresh-0"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// cada Auth representa um processo que leu as credenciais antes do refresh
+	auths := make([]*authValue, 10)
+	for i := range auths {
+		auths[i] = newTestAuth(ws, server.URL)
+		auths[i].load()
+	}
+
+	tokens := make([]string, len(auths))
+	var wg sync.WaitGroup
+	for i, auth := range auths {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tokens[i] = auth.GetAccessToken(context.Background())
+		}()
+	}
+	wg.Wait()
+
+	if fake.count != 1 {
+		t.Errorf("token refreshed %d times, want 1", fake.count)
+	}
+	for i, token := range tokens {
+		if token == "" || token == expired || token != tokens[0] {
+			t.Errorf("auth %d got token %q, want the refreshed token", i, token)
+		}
+	}
+
+	saved, err := NewFileStore(ws.Dir()).Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved.RefreshToken != "refresh-1" {
+		t.Errorf("saved refresh token = %q, want the rotated refresh-1", saved.RefreshToken)
+	}
+	if saved.AccessToken != tokens[0] {
+		t.Error("saved access token differs from the returned one")
+	}
+
+	// o refresh token rotacionado continua valendo para a próxima renovação
+	next := newTestAuth(ws, server.URL)
+	if err := next.RefreshToken(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if fake.count != 2 || next.GetRefreshToken() != "refresh-2" {
+		t.Errorf("second refresh: count %d, refresh token %q", fake.count, next.GetRefreshToken())
+	}
+}
+
+func TestGetAccessTokenKeepsValidToken(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	ws := workspace.NewWorkspace().Current()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("valid token was refreshed")
+	}))
+	defer server.Close()
+
+	valid := newToken(t, time.Hour)
+	if err := NewFileStore(ws.Dir()).Save(AuthFile{AccessToken: valid, RefreshToken: "refresh-0"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := newTestAuth(ws, server.URL).GetAccessToken(context.Background()); got != valid {
+		t.Errorf("got %q, want the saved token", got)
+	}
+}
+
+func TestValidateToken(t *testing.T) {
+	tests := []struct {
+		name      string
+		token     string
+		margin    time.Duration
+		expectErr bool
+	}{
+		{name: "valid", token: newToken(t, time.Hour), margin: tokenRefreshWindow},
+		{name: "inside refresh window", token: newToken(t, time.Minute), margin: tokenRefreshWindow, expectErr: true},
+		{name: "inside refresh window without margin", token: newToken(t, time.Minute)},
+		{name: "expired", token: newToken(t, -time.Minute), expectErr: true},
+		{name: "empty", token: "", expectErr: true},
+		{name: "malformed", token: "not-a-jwt", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auth := &authValue{authValue: AuthFile{AccessToken: tt.token}, loaded: true}
+			err := auth.validateToken(tt.margin)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("validateToken(%s) = %v, expect error %v", tt.margin, err, tt.expectErr)
+			}
+		})
+	}
+
+	noExpiry, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, TokenClaims{}).SignedString([]byte("test"))
+	auth := &authValue{authValue: AuthFile{AccessToken: noExpiry}, loaded: true}
+	if err := auth.ValidateToken(); err != nil {
+		t.Errorf("token without exp: %v", err)
+	}
+}
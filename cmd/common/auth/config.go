@@ -14,6 +14,14 @@ type Config struct {
 	RedirectURI string
 	Scopes      []string
 
+	// DeviceAuthURL é o endpoint de autorização de dispositivo (RFC 8628),
+	// usado no login sem navegador
+	DeviceAuthURL string
+
+	// Issuer é o emissor OIDC. Os endpoints vazios são lidos do seu
+	// documento de descoberta (/.well-known/openid-configuration)
+	Issuer string
+
 	// Server Configuration
 	ListenAddr string
 	Timeout    time.Duration
@@ -30,6 +38,7 @@ func DefaultConfig() *Config {
 		AuthURL:     "https://id.magalu.com/login",
 		TokenURL:    "https://id.magalu.com/oauth/token",
 		RedirectURI: "http://localhost:8095/callback",
+		Issuer:      "https://id.magalu.com",
 		Scopes: []string{
 			"mke.write", "api-consulta.read", "openid", "mcr.read", "dbaas.write",
 			"cpo:read", "cpo:write", "evt:event-tr", "network.read", "network.write",
@@ -47,6 +56,21 @@ func DefaultConfig() *Config {
 	}
 }
 
+// ConfigForEnv retorna a configuração de autenticação do ambiente informado.
+// O pre-prod não tem valores embutidos: o emissor e o client id vêm de
+// MGC_PREPROD_ISSUER e MGC_PREPROD_CLIENT_ID e os endpoints, da descoberta
+func ConfigForEnv(env string) *Config {
+	config := DefaultConfig()
+	if env == "pre-prod" {
+		config.ClientID = os.Getenv("MGC_PREPROD_CLIENT_ID")
+		config.Issuer = os.Getenv("MGC_PREPROD_ISSUER")
+		config.AuthURL = ""
+		config.TokenURL = ""
+		config.DeviceAuthURL = ""
+	}
+	return config
+}
+
 // getListenAddr retorna o endereço de escuta do servidor de callback
 // Verifica a variável de ambiente MGC_LISTEN_ADDRESS ou usa o padrão
 func getListenAddr() string {
@@ -0,0 +1,162 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	deviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+	// intervalo padrão e acréscimo em slow_down definidos na RFC 8628, em
+	// unidades de deviceTimeUnit
+	defaultDeviceInterval = 5
+	slowDownIncrement     = 5
+)
+
+// deviceTimeUnit é a unidade do intervalo e da validade (expires_in)
+// informados pelo servidor, em segundos pela RFC 8628
+var deviceTimeUnit = time.Second
+
+var (
+	ErrDeviceCodeExpired  = errors.New("device code expired before the login was approved, run the login again")
+	ErrDeviceAccessDenied = errors.New("login was denied in the browser")
+)
+
+// DeviceAuthorization é a resposta do endpoint de autorização de dispositivo (RFC 8628)
+type DeviceAuthorization struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete,omitempty"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval,omitempty"`
+}
+
+// VerificationURL retorna a URL que o usuário deve abrir, já com o código
+// quando o servidor a fornece
+func (d *DeviceAuthorization) VerificationURL() string {
+	if d.VerificationURIComplete != "" {
+		return d.VerificationURIComplete
+	}
+	return d.VerificationURI
+}
+
+type oauthError struct {
+	Error       string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+// RequestDeviceAuthorization inicia o fluxo de dispositivo, obtendo o código
+// do dispositivo e o código que o usuário informa na página de verificação
+func (c *OAuthClient) RequestDeviceAuthorization(ctx context.Context) (*DeviceAuthorization, error) {
+	data := url.Values{}
+	data.Set("client_id", c.config.ClientID)
+	data.Set("scope", strings.Join(c.config.Scopes, " "))
+
+	status, body, err := c.postForm(ctx, c.config.DeviceAuthURL, data)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("device authorization request failed with status %d: %s", status, string(body))
+	}
+
+	var device DeviceAuthorization
+	if err := json.Unmarshal(body, &device); err != nil {
+		return nil, fmt.Errorf("failed to decode device authorization response: %w", err)
+	}
+	if device.DeviceCode == "" || device.VerificationURI == "" {
+		return nil, fmt.Errorf("invalid device authorization response: %s", string(body))
+	}
+	return &device, nil
+}
+
+// PollDeviceToken consulta o endpoint de token até o usuário aprovar o
+// login, respeitando o intervalo informado pelo servidor e aumentando-o a
+// cada slow_down
+func (c *OAuthClient) PollDeviceToken(ctx context.Context, device *DeviceAuthorization) (*TokenResponse, error) {
+	interval := time.Duration(defaultDeviceInterval) * deviceTimeUnit
+	if device.Interval > 0 {
+		interval = time.Duration(device.Interval) * deviceTimeUnit
+	}
+	if device.ExpiresIn > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(device.ExpiresIn)*deviceTimeUnit)
+		defer cancel()
+	}
+
+	data := url.Values{}
+	data.Set("client_id", c.config.ClientID)
+	data.Set("grant_type", deviceCodeGrantType)
+	data.Set("device_code", device.DeviceCode)
+
+	for {
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil, ErrDeviceCodeExpired
+			}
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+
+		status, body, err := c.postForm(ctx, c.config.TokenURL, data)
+		if err != nil {
+			if ctx.Err() != nil {
+				continue
+			}
+			return nil, err
+		}
+
+		if status == http.StatusOK {
+			var tokenResp TokenResponse
+			if err := json.Unmarshal(body, &tokenResp); err != nil {
+				return nil, fmt.Errorf("failed to decode token response: %w", err)
+			}
+			return &tokenResp, nil
+		}
+
+		var oauthErr oauthError
+		_ = json.Unmarshal(body, &oauthErr)
+		switch oauthErr.Error {
+		case "authorization_pending":
+		case "slow_down":
+			interval += slowDownIncrement * deviceTimeUnit
+		case "expired_token":
+			return nil, ErrDeviceCodeExpired
+		case "access_denied":
+			return nil, ErrDeviceAccessDenied
+		default:
+			return nil, fmt.Errorf("token request failed with status %d: %s", status, string(body))
+		}
+	}
+}
+
+func (c *OAuthClient) postForm(ctx context.Context, endpoint string, data url.Values) (int, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(data.Encode()))
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	return resp.StatusCode, body, nil
+}
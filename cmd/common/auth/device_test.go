@@ -0,0 +1,156 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeTokenServer responde ao endpoint de token com as respostas informadas,
+// em ordem, e registra o horário de cada requisição
+type fakeTokenServer struct {
+	mu        sync.Mutex
+	responses []string
+	requests  []time.Time
+}
+
+func (f *fakeTokenServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := r.ParseForm(); err != nil || r.Form.Get("grant_type") != deviceCodeGrantType || r.Form.Get("device_code") != "device-code" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	f.requests = append(f.requests, time.Now())
+
+	response := f.responses[0]
+	if len(f.responses) > 1 {
+		f.responses = f.responses[1:]
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response == "success" {
+		json.NewEncoder(w).Encode(TokenResponse{AccessToken: "access", RefreshToken: "refresh"})
+		return
+	}
+	w.WriteHeader(http.StatusBadRequest)
+	json.NewEncoder(w).Encode(oauthError{Error: response})
+}
+
+func newDeviceTestClient(t *testing.T, server *httptest.Server) *OAuthClient {
+	t.Helper()
+	previous := deviceTimeUnit
+	deviceTimeUnit = time.Millisecond
+	t.Cleanup(func() { deviceTimeUnit = previous })
+
+	config := DefaultConfig()
+	config.TokenURL = server.URL + "/token"
+	config.DeviceAuthURL = server.URL + "/device"
+	client, err := NewOAuthClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestPollDeviceToken(t *testing.T) {
+	tests := []struct {
+		name      string
+		responses []string
+		err       error
+		requests  int
+	}{
+		{name: "success", responses: []string{"success"}, requests: 1},
+		{name: "authorization pending", responses: []string{"authorization_pending", "authorization_pending", "success"}, requests: 3},
+		{name: "expired token", responses: []string{"authorization_pending", "expired_token"}, err: ErrDeviceCodeExpired, requests: 2},
+		{name: "access denied", responses: []string{"access_denied"}, err: ErrDeviceAccessDenied, requests: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeTokenServer{responses: tt.responses}
+			server := httptest.NewServer(fake)
+			defer server.Close()
+			client := newDeviceTestClient(t, server)
+
+			token, err := client.PollDeviceToken(context.Background(), &DeviceAuthorization{DeviceCode: "device-code", Interval: 1})
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("got error %v, want %v", err, tt.err)
+			}
+			if tt.err == nil && (token == nil || token.AccessToken != "access") {
+				t.Errorf("unexpected token %+v", token)
+			}
+			if len(fake.requests) != tt.requests {
+				t.Errorf("got %d requests, want %d", len(fake.requests), tt.requests)
+			}
+		})
+	}
+}
+
+func TestPollDeviceTokenSlowDown(t *testing.T) {
+	fake := &fakeTokenServer{responses: []string{"slow_down", "slow_down", "success"}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	client := newDeviceTestClient(t, server)
+
+	if _, err := client.PollDeviceToken(context.Background(), &DeviceAuthorization{DeviceCode: "device-code", Interval: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if len(fake.requests) != 3 {
+		t.Fatalf("got %d requests, want 3", len(fake.requests))
+	}
+
+	// cada slow_down acrescenta 5 unidades ao intervalo de 1
+	for i, minimum := range []time.Duration{6 * time.Millisecond, 11 * time.Millisecond} {
+		if gap := fake.requests[i+1].Sub(fake.requests[i]); gap < minimum {
+			t.Errorf("request %d came %s after the previous one, want at least %s", i+2, gap, minimum)
+		}
+	}
+}
+
+func TestPollDeviceTokenExpiresIn(t *testing.T) {
+	fake := &fakeTokenServer{responses: []string{"authorization_pending"}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	client := newDeviceTestClient(t, server)
+
+	// intervalo e validade usam a mesma unidade: 50 unidades de validade
+	// permitem no máximo 10 consultas com intervalo de 5
+	_, err := client.PollDeviceToken(context.Background(), &DeviceAuthorization{DeviceCode: "device-code", Interval: 5, ExpiresIn: 50})
+	if !errors.Is(err, ErrDeviceCodeExpired) {
+		t.Fatalf("got error %v, want %v", err, ErrDeviceCodeExpired)
+	}
+	if len(fake.requests) == 0 || len(fake.requests) > 10 {
+		t.Errorf("got %d requests, want between 1 and 10", len(fake.requests))
+	}
+}
+
+func TestRequestDeviceAuthorization(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil || r.Form.Get("client_id") == "" || r.Form.Get("scope") == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		json.NewEncoder(w).Encode(DeviceAuthorization{
+			DeviceCode:      "device-code",
+			UserCode:        "ABCD-EFGH",
+			VerificationURI: "https://example.com/device",
+			ExpiresIn:       600,
+		})
+	}))
+	defer server.Close()
+	client := newDeviceTestClient(t, server)
+
+	device, err := client.RequestDeviceAuthorization(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if device.UserCode != "ABCD-EFGH" || device.VerificationURL() != "https://example.com/device" {
+		t.Errorf("unexpected device authorization %+v", device)
+	}
+}
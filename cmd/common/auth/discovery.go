@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const discoveryPath = "/.well-known/openid-configuration"
+
+var ErrAuthNotConfigured = errors.New("authentication is not configured for this environment: set MGC_PREPROD_ISSUER and MGC_PREPROD_CLIENT_ID")
+
+// discoveryDocument contém os campos usados do documento de descoberta OIDC
+type discoveryDocument struct {
+	AuthorizationEndpoint       string `json:"authorization_endpoint"`
+	TokenEndpoint               string `json:"token_endpoint"`
+	DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint"`
+}
+
+// resolve preenche os endpoints vazios com os do documento de descoberta do
+// Issuer. O documento só é consultado quando falta algum endpoint necessário:
+// device indica se o fluxo de dispositivo será usado
+func (c *Config) resolve(ctx context.Context, httpClient *http.Client, device bool) error {
+	if c.ClientID == "" {
+		return ErrAuthNotConfigured
+	}
+	if c.AuthURL != "" && c.TokenURL != "" && (!device || c.DeviceAuthURL != "") {
+		return nil
+	}
+	if c.Issuer == "" {
+		return ErrAuthNotConfigured
+	}
+
+	doc, err := fetchDiscovery(ctx, httpClient, c.Issuer)
+	if err != nil {
+		return err
+	}
+	if c.AuthURL == "" {
+		c.AuthURL = doc.AuthorizationEndpoint
+	}
+	if c.TokenURL == "" {
+		c.TokenURL = doc.TokenEndpoint
+	}
+	if c.DeviceAuthURL == "" {
+		c.DeviceAuthURL = doc.DeviceAuthorizationEndpoint
+	}
+
+	if c.AuthURL == "" || c.TokenURL == "" {
+		return fmt.Errorf("the identity provider %s does not advertise its authorization and token endpoints", c.Issuer)
+	}
+	if device && c.DeviceAuthURL == "" {
+		return fmt.Errorf("the identity provider %s does not support the device authorization flow, log in without --headless or --qrcode", c.Issuer)
+	}
+	return nil
+}
+
+func fetchDiscovery(ctx context.Context, httpClient *http.Client, issuer string) (*discoveryDocument, error) {
+	endpoint := strings.TrimSuffix(issuer, "/") + discoveryPath
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create discovery request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", endpoint, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("discovery request to %s failed with status %d: %s", endpoint, resp.StatusCode, string(body))
+	}
+
+	var doc discoveryDocument
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return nil, fmt.Errorf("failed to decode discovery document: %w", err)
+	}
+	return &doc, nil
+}
@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newDiscoveryServer serve o documento de descoberta informado e conta as consultas
+func newDiscoveryServer(t *testing.T, doc map[string]string) (*httptest.Server, *int) {
+	t.Helper()
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != discoveryPath {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(doc)
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestResolveDeviceEndpoint(t *testing.T) {
+	server, requests := newDiscoveryServer(t, map[string]string{
+		"authorization_endpoint":        "https://idp.example/authorize",
+		"token_endpoint":                "https://idp.example/token",
+		"device_authorization_endpoint": "https://idp.example/device",
+	})
+	config := DefaultConfig()
+	config.Issuer = server.URL + "/"
+
+	// o login pelo navegador usa os endpoints configurados, sem descoberta
+	if err := config.resolve(context.Background(), server.Client(), false); err != nil {
+		t.Fatal(err)
+	}
+	if *requests != 0 {
+		t.Fatalf("got %d discovery requests, want 0", *requests)
+	}
+
+	for range 2 {
+		if err := config.resolve(context.Background(), server.Client(), true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if *requests != 1 {
+		t.Errorf("got %d discovery requests, want 1", *requests)
+	}
+	if config.DeviceAuthURL != "https://idp.example/device" {
+		t.Errorf("DeviceAuthURL = %q", config.DeviceAuthURL)
+	}
+	if config.TokenURL != DefaultConfig().TokenURL || config.AuthURL != DefaultConfig().AuthURL {
+		t.Errorf("configured endpoints were replaced: %q, %q", config.AuthURL, config.TokenURL)
+	}
+}
+
+func TestResolvePreProd(t *testing.T) {
+	server, _ := newDiscoveryServer(t, map[string]string{
+		"authorization_endpoint": "https://idp.example/authorize",
+		"token_endpoint":         "https://idp.example/token",
+	})
+
+	t.Setenv("MGC_PREPROD_ISSUER", "")
+	t.Setenv("MGC_PREPROD_CLIENT_ID", "")
+	config := ConfigForEnv("pre-prod")
+	if err := config.resolve(context.Background(), server.Client(), false); !errors.Is(err, ErrAuthNotConfigured) {
+		t.Fatalf("got error %v, want %v", err, ErrAuthNotConfigured)
+	}
+
+	t.Setenv("MGC_PREPROD_ISSUER", server.URL)
+	t.Setenv("MGC_PREPROD_CLIENT_ID", "preprod-client")
+	config = ConfigForEnv("pre-prod")
+	if err := config.resolve(context.Background(), server.Client(), false); err != nil {
+		t.Fatal(err)
+	}
+	if config.ClientID != "preprod-client" || config.AuthURL != "https://idp.example/authorize" || config.TokenURL != "https://idp.example/token" {
+		t.Errorf("unexpected config %+v", config)
+	}
+
+	// o documento não anuncia o endpoint de dispositivo
+	if err := config.resolve(context.Background(), server.Client(), true); err == nil {
+		t.Error("expected an error for an identity provider without device authorization")
+	}
+}
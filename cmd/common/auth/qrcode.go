@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"fmt"
+	"strings"
+
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+// QRCodeMatrix codifica content como QR code e retorna a matriz de módulos
+// (true: módulo escuro), já incluindo a zona de silêncio ao redor
+func QRCodeMatrix(content string) ([][]bool, error) {
+	code, err := qrcode.New(content, qrcode.Medium)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode QR code: %w", err)
+	}
+	return code.Bitmap(), nil
+}
+
+// RenderQRCode desenha a matriz usando meio-blocos Unicode, duas linhas de
+// módulos por linha do terminal. Os módulos claros são desenhados com o
+// caractere cheio, assim o código aparece escuro sobre claro em terminais
+// de fundo escuro
+func RenderQRCode(matrix [][]bool) string {
+	var sb strings.Builder
+	for y := 0; y < len(matrix); y += 2 {
+		for x := range matrix[y] {
+			top := !matrix[y][x]
+			// a última linha ímpar é completada com a zona de silêncio (clara)
+			bottom := y+1 >= len(matrix) || !matrix[y+1][x]
+			switch {
+			case top && bottom:
+				sb.WriteRune('█')
+			case top:
+				sb.WriteRune('▀')
+			case bottom:
+				sb.WriteRune('▄')
+			default:
+				sb.WriteRune(' ')
+			}
+		}
+		sb.WriteRune('\n')
+	}
+	return sb.String()
+}
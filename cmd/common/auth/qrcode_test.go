@@ -0,0 +1,106 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+)
+
+// decodeQRCode faz o caminho inverso de RenderQRCode, devolvendo a matriz de
+// módulos (true: escuro) com as linhas completadas pela renderização
+func decodeQRCode(t *testing.T, rendered string) [][]bool {
+	t.Helper()
+	matrix := [][]bool{}
+	for _, line := range strings.Split(strings.TrimSuffix(rendered, "\n"), "\n") {
+		top, bottom := []bool{}, []bool{}
+		for _, char := range line {
+			switch char {
+			case '█':
+				top, bottom = append(top, false), append(bottom, false)
+			case '▀':
+				top, bottom = append(top, false), append(bottom, true)
+			case '▄':
+				top, bottom = append(top, true), append(bottom, false)
+			case ' ':
+				top, bottom = append(top, true), append(bottom, true)
+			default:
+				t.Fatalf("unexpected character %q in rendered QR code", char)
+			}
+		}
+		matrix = append(matrix, top, bottom)
+	}
+	return matrix
+}
+
+func assertSameRows(t *testing.T, got [][]bool, want [][]bool) {
+	t.Helper()
+	for y := range want {
+		if len(got[y]) != len(want[y]) {
+			t.Fatalf("row %d has %d modules, want %d", y, len(got[y]), len(want[y]))
+		}
+		for x := range want[y] {
+			if got[y][x] != want[y][x] {
+				t.Fatalf("module (%d,%d) = %v, want %v", x, y, got[y][x], want[y][x])
+			}
+		}
+	}
+}
+
+func TestRenderQRCodeMapping(t *testing.T) {
+	// cada coluna combina um par de módulos: claro/claro, claro/escuro,
+	// escuro/claro e escuro/escuro
+	matrix := [][]bool{
+		{false, false, true, true},
+		{false, true, false, true},
+	}
+	if got := RenderQRCode(matrix); got != "█▀▄ \n" {
+		t.Errorf("got %q, want %q", got, "█▀▄ \n")
+	}
+}
+
+func TestRenderQRCodeOddHeight(t *testing.T) {
+	matrix := [][]bool{
+		{true, false},
+		{false, true},
+		{true, true},
+	}
+	decoded := decodeQRCode(t, RenderQRCode(matrix))
+	if len(decoded) != 4 {
+		t.Fatalf("got %d rows, want 4", len(decoded))
+	}
+	assertSameRows(t, decoded, matrix)
+	// a linha extra é desenhada como zona de silêncio
+	for x, dark := range decoded[3] {
+		if dark {
+			t.Errorf("padding module %d is dark", x)
+		}
+	}
+}
+
+func TestRenderQRCodeRoundTrip(t *testing.T) {
+	matrix, err := QRCodeMatrix("https://id.magalu.com/device?user_code=ABCD-EFGH")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decoded := decodeQRCode(t, RenderQRCode(matrix))
+	if len(decoded) < len(matrix) || len(decoded) > len(matrix)+1 {
+		t.Fatalf("got %d rows, want %d", len(decoded), len(matrix))
+	}
+	assertSameRows(t, decoded, matrix)
+
+	// zona de silêncio de 4 módulos claros em todas as bordas
+	const quietZone = 4
+	size := len(matrix)
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			border := x < quietZone || y < quietZone || x >= size-quietZone || y >= size-quietZone
+			if border && matrix[y][x] {
+				t.Fatalf("module (%d,%d) in the quiet zone is dark", x, y)
+			}
+		}
+	}
+	// o padrão de localização começa logo após a zona de silêncio
+	if !matrix[quietZone][quietZone] {
+		t.Error("finder pattern not found after the quiet zone")
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"os"
 	"strings"
 
 	_ "embed"
@@ -34,6 +35,10 @@ func NewService(config *Config) *Service {
 
 // Login executa o fluxo de autenticação OAuth com as opções fornecidas
 func (s *Service) Login(ctx context.Context, opts LoginOptions) (*TokenResponse, error) {
+	if err := s.config.resolve(ctx, http.DefaultClient, opts.QRCode || opts.Headless); err != nil {
+		return nil, err
+	}
+
 	if opts.QRCode {
 		return s.qrCodeLogin(ctx)
 	}
@@ -49,6 +54,9 @@ func (s *Service) RefreshToken(ctx context.Context, refreshToken string) (*Token
 	if refreshToken == "" {
 		return nil, fmt.Errorf("RefreshToken is not set")
 	}
+	if err := s.config.resolve(ctx, http.DefaultClient, false); err != nil {
+		return nil, err
+	}
 
 	data := url.Values{}
 	data.Set("client_id", s.config.ClientID)
@@ -114,10 +122,10 @@ func (s *Service) browserLogin(ctx context.Context, showToken bool) (*TokenRespo
 	}
 
 	// Abrir navegador
-	fmt.Printf("Abrindo navegador em: %s://%s\n", authURL.Scheme, authURL.Host)
+	fmt.Fprintf(os.Stderr, "Abrindo navegador em: %s://%s\n", authURL.Scheme, authURL.Host)
 	if err := browser.OpenURL(authURL.String()); err != nil {
-		fmt.Printf("Não foi possível abrir o navegador automaticamente.\n")
-		fmt.Printf("Por favor, abra manualmente: %s\n", authURL.String())
+		fmt.Fprintf(os.Stderr, "Não foi possível abrir o navegador automaticamente.\n")
+		fmt.Fprintf(os.Stderr, "Por favor, abra manualmente: %s\n", authURL.String())
 	}
 
 	// Aguardar resultado
@@ -137,14 +145,58 @@ func (s *Service) browserLogin(ctx context.Context, showToken bool) (*TokenRespo
 	return result.Token, nil
 }
 
-// headlessLogin executa o fluxo de login sem abrir navegador
-// TODO: Implementar fluxo headless (device flow)
+// headlessLogin executa o fluxo de login sem abrir navegador (device flow,
+// RFC 8628): o usuário aprova o login em outro dispositivo informando o código.
+// As instruções vão para stderr para não misturar com a saída do comando
 func (s *Service) headlessLogin(ctx context.Context) (*TokenResponse, error) {
-	return nil, fmt.Errorf("headless login not implemented yet")
+	client, err := NewOAuthClient(s.config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OAuth client: %w", err)
+	}
+
+	device, err := client.RequestDeviceAuthorization(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Fprintf(os.Stderr, "Para autenticar, abra em qualquer navegador: %s\n", device.VerificationURI)
+	fmt.Fprintf(os.Stderr, "E informe o código: %s\n", device.UserCode)
+	if device.VerificationURIComplete != "" {
+		fmt.Fprintf(os.Stderr, "Ou acesse diretamente: %s\n", device.VerificationURIComplete)
+	}
+	fmt.Fprintln(os.Stderr, "Aguardando aprovação...")
+
+	return client.PollDeviceToken(ctx, device)
 }
 
-// qrCodeLogin executa o fluxo de login exibindo um QR code
-// TODO: Implementar fluxo com QR code
+// qrCodeLogin executa o fluxo de login exibindo um QR code com a URL de
+// verificação do device flow, para aprovar o login pelo celular
 func (s *Service) qrCodeLogin(ctx context.Context) (*TokenResponse, error) {
-	return nil, fmt.Errorf("QR code login not implemented yet")
+	client, err := NewOAuthClient(s.config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OAuth client: %w", err)
+	}
+
+	device, err := client.RequestDeviceAuthorization(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	verificationURL := device.VerificationURL()
+	matrix, err := QRCodeMatrix(verificationURL)
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Fprintln(os.Stderr, "Escaneie o QR code com o celular para autenticar:")
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprint(os.Stderr, RenderQRCode(matrix))
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintf(os.Stderr, "Se não conseguir escanear, acesse: %s\n", verificationURL)
+	if device.VerificationURIComplete == "" {
+		fmt.Fprintf(os.Stderr, "E informe o código: %s\n", device.UserCode)
+	}
+	fmt.Fprintln(os.Stderr, "Aguardando aprovação...")
+
+	return client.PollDeviceToken(ctx, device)
 }
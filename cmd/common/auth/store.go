@@ -0,0 +1,253 @@
+package auth
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"runtime"
+
+	"filippo.io/age"
+	"github.com/magaluCloud/mgccli/cmd/common/structs"
+	"github.com/magaluCloud/mgccli/cmd/common/workspace"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	StoreFile      = "file"
+	StoreEncrypted = "encrypted"
+
+	authFileName          = "auth.yaml"
+	encryptedAuthFileName = "auth.yaml.age"
+
+	CREDENTIALS_FILE_PERMISSION = 0600
+)
+
+// CredentialStore guarda as credenciais de um workspace. Outros backends
+// (ex: keychain do sistema) só precisam implementar esta interface
+type CredentialStore interface {
+	Kind() string
+	Load() (AuthFile, error)
+	Save(content AuthFile) error
+	Delete() error
+}
+
+// PassphraseFunc obtém a senha usada para cifrar as credenciais. Com confirm,
+// a senha é pedida de novo para confirmar uma senha nova
+type PassphraseFunc func(confirm bool) (string, error)
+
+type fileStore struct {
+	dir string
+}
+
+// NewFileStore guarda as credenciais em texto puro no auth.yaml do
+// workspace, legível apenas pelo dono do arquivo
+func NewFileStore(dir string) CredentialStore {
+	return &fileStore{dir: dir}
+}
+
+func (s *fileStore) Kind() string {
+	return StoreFile
+}
+
+func (s *fileStore) Load() (AuthFile, error) {
+	filePath := path.Join(s.dir, authFileName)
+	if !fileExists(filePath) && fileExists(path.Join(s.dir, encryptedAuthFileName)) {
+		return AuthFile{}, fmt.Errorf("credentials in %s are encrypted, set credential-store to %s to read them",
+			path.Join(s.dir, encryptedAuthFileName), StoreEncrypted)
+	}
+	fixPermissions(s.dir, filePath)
+	return structs.LoadFileToStruct[AuthFile](filePath)
+}
+
+func (s *fileStore) Save(content AuthFile) error {
+	data, err := yaml.Marshal(content)
+	if err != nil {
+		return err
+	}
+	return workspace.WriteFileAtomic(path.Join(s.dir, authFileName), data, CREDENTIALS_FILE_PERMISSION)
+}
+
+func (s *fileStore) Delete() error {
+	return removeCredentials(s.dir)
+}
+
+type encryptedStore struct {
+	dir        string
+	passphrase PassphraseFunc
+	cached     string
+}
+
+// NewEncryptedStore cifra as credenciais com age (scrypt), usando a senha
+// obtida por passphrase. Credenciais em texto puro existentes são migradas
+// para o arquivo cifrado na primeira leitura, quando a senha está disponível
+func NewEncryptedStore(dir string, passphrase PassphraseFunc) CredentialStore {
+	return &encryptedStore{dir: dir, passphrase: passphrase}
+}
+
+func (s *encryptedStore) Kind() string {
+	return StoreEncrypted
+}
+
+func (s *encryptedStore) Load() (AuthFile, error) {
+	filePath := path.Join(s.dir, encryptedAuthFileName)
+	if !fileExists(filePath) {
+		plainPath := path.Join(s.dir, authFileName)
+		if !fileExists(plainPath) {
+			return structs.InitConfig[AuthFile](), nil
+		}
+		content, err := structs.LoadFileToStruct[AuthFile](plainPath)
+		if err != nil {
+			return AuthFile{}, err
+		}
+		if err := s.Save(content); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %s is still stored in plain text: %s\n", plainPath, err)
+		}
+		return content, nil
+	}
+
+	fixPermissions(s.dir, filePath)
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return AuthFile{}, err
+	}
+	passphrase, err := s.getPassphrase(false)
+	if err != nil {
+		return AuthFile{}, err
+	}
+	identity, err := age.NewScryptIdentity(passphrase)
+	if err != nil {
+		return AuthFile{}, err
+	}
+	reader, err := age.Decrypt(bytes.NewReader(data), identity)
+	if err != nil {
+		return AuthFile{}, fmt.Errorf("failed to decrypt credentials (wrong passphrase?): %w", err)
+	}
+	plain, err := io.ReadAll(reader)
+	if err != nil {
+		return AuthFile{}, err
+	}
+
+	content := AuthFile{}
+	if err := yaml.Unmarshal(plain, &content); err != nil {
+		return AuthFile{}, err
+	}
+	// a senha só fica em memória depois de confirmada pela decifragem
+	s.cached = passphrase
+	return content, nil
+}
+
+func (s *encryptedStore) Save(content AuthFile) error {
+	data, err := yaml.Marshal(content)
+	if err != nil {
+		return err
+	}
+	// sem senha confirmada por uma leitura, o arquivo cifrado ainda não existe
+	// e a senha nova é pedida duas vezes
+	passphrase, err := s.getPassphrase(s.cached == "")
+	if err != nil {
+		return err
+	}
+	recipient, err := age.NewScryptRecipient(passphrase)
+	if err != nil {
+		return err
+	}
+
+	var encrypted bytes.Buffer
+	writer, err := age.Encrypt(&encrypted, recipient)
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(data); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	if err := workspace.WriteFileAtomic(path.Join(s.dir, encryptedAuthFileName), encrypted.Bytes(), CREDENTIALS_FILE_PERMISSION); err != nil {
+		return err
+	}
+	s.cached = passphrase
+
+	// depois de cifradas, as credenciais não podem continuar em texto puro
+	err = os.Remove(path.Join(s.dir, authFileName))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s *encryptedStore) Delete() error {
+	return removeCredentials(s.dir)
+}
+
+// getPassphrase retorna a senha já confirmada ou a pede. Com confirm, a senha
+// é pedida duas vezes, já que um erro de digitação ao cifrar as credenciais
+// pela primeira vez as tornaria ilegíveis
+func (s *encryptedStore) getPassphrase(confirm bool) (string, error) {
+	if s.cached != "" {
+		return s.cached, nil
+	}
+	if s.passphrase == nil {
+		return "", fmt.Errorf("no passphrase available to encrypt the credentials")
+	}
+	passphrase, err := s.passphrase(false)
+	if err != nil {
+		return "", err
+	}
+	if passphrase == "" {
+		return "", fmt.Errorf("passphrase cannot be empty")
+	}
+	if !confirm {
+		return passphrase, nil
+	}
+
+	confirmation, err := s.passphrase(true)
+	if err != nil {
+		return "", err
+	}
+	if confirmation != passphrase {
+		return "", fmt.Errorf("passphrases do not match, the credentials were not encrypted")
+	}
+	return passphrase, nil
+}
+
+// fixPermissions restringe ao dono o arquivo de credenciais e o diretório do
+// workspace criados por versões anteriores com permissões abertas
+func fixPermissions(dir string, filePath string) {
+	if runtime.GOOS == "windows" {
+		return
+	}
+	restrict(dir, workspace.DIR_PERMISSION)
+	restrict(filePath, CREDENTIALS_FILE_PERMISSION)
+}
+
+func restrict(filePath string, perm os.FileMode) {
+	info, err := os.Stat(filePath)
+	if err != nil || info.Mode().Perm()&0077 == 0 {
+		return
+	}
+	if err := os.Chmod(filePath, perm); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %s is accessible by other users and its permissions could not be changed: %s\n", filePath, err)
+		return
+	}
+	fmt.Fprintf(os.Stderr, "Warning: %s was accessible by other users, permissions changed to %o\n", filePath, perm)
+}
+
+func removeCredentials(dir string) error {
+	for _, name := range []string{authFileName, encryptedAuthFileName} {
+		err := os.Remove(path.Join(dir, name))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
+
+func fileExists(filePath string) bool {
+	_, err := os.Stat(filePath)
+	return err == nil
+}
@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type countingStore struct {
+	CredentialStore
+	loads int
+}
+
+func (s *countingStore) Load() (AuthFile, error) {
+	s.loads++
+	return AuthFile{AccessKeyID: "key"}, nil
+}
+
+func TestNewAuthLoadsCredentialsOnFirstUse(t *testing.T) {
+	store := &countingStore{}
+	auth := NewAuth(nil, "prod", store)
+	if store.loads != 0 {
+		t.Fatalf("credentials loaded %d times before being used", store.loads)
+	}
+
+	if got := auth.GetAccessKeyID(); got != "key" {
+		t.Errorf("got %q, want %q", got, "key")
+	}
+	auth.GetAccessKeyID()
+	if store.loads != 1 {
+		t.Errorf("credentials loaded %d times, want 1", store.loads)
+	}
+}
+
+// passphrases responde a cada pedido com a próxima senha da lista
+func passphrases(values ...string) (PassphraseFunc, *[]bool) {
+	asked := []bool{}
+	return func(confirm bool) (string, error) {
+		asked = append(asked, confirm)
+		value := values[0]
+		values = values[1:]
+		return value, nil
+	}, &asked
+}
+
+func TestEncryptedStoreConfirmsNewPassphrase(t *testing.T) {
+	dir := t.TempDir()
+	passphrase, asked := passphrases("secret", "secret")
+	store := NewEncryptedStore(dir, passphrase)
+
+	if err := store.Save(AuthFile{AccessToken: "token"}); err != nil {
+		t.Fatal(err)
+	}
+	if len(*asked) != 2 || (*asked)[0] || !(*asked)[1] {
+		t.Fatalf("passphrase requests = %v, want [false true]", *asked)
+	}
+
+	// a senha confirmada fica em memória para as próximas gravações
+	if err := store.Save(AuthFile{AccessToken: "other"}); err != nil {
+		t.Fatal(err)
+	}
+	if len(*asked) != 2 {
+		t.Errorf("passphrase asked again after being confirmed")
+	}
+
+	reader := NewEncryptedStore(dir, func(bool) (string, error) { return "secret", nil })
+	content, err := reader.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if content.AccessToken != "other" {
+		t.Errorf("got %q, want %q", content.AccessToken, "other")
+	}
+}
+
+func TestEncryptedStoreRejectsMismatchedPassphrase(t *testing.T) {
+	dir := t.TempDir()
+	passphrase, _ := passphrases("secret", "secrte")
+	store := NewEncryptedStore(dir, passphrase)
+
+	if err := store.Save(AuthFile{AccessToken: "token"}); err == nil {
+		t.Fatal("expected an error for mismatched passphrases")
+	}
+	if _, err := os.Stat(filepath.Join(dir, encryptedAuthFileName)); !os.IsNotExist(err) {
+		t.Errorf("encrypted credentials were written: %v", err)
+	}
+}
+
+func TestEncryptedStoreAsksOnceForExistingFile(t *testing.T) {
+	dir := t.TempDir()
+	writer := NewEncryptedStore(dir, func(bool) (string, error) { return "secret", nil })
+	if err := writer.Save(AuthFile{AccessToken: "token"}); err != nil {
+		t.Fatal(err)
+	}
+
+	passphrase, asked := passphrases("secret")
+	store := NewEncryptedStore(dir, passphrase)
+	if _, err := store.Load(); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Save(AuthFile{AccessToken: "new"}); err != nil {
+		t.Fatal(err)
+	}
+	if len(*asked) != 1 || (*asked)[0] {
+		t.Errorf("passphrase requests = %v, want [false]", *asked)
+	}
+}
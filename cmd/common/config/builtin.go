@@ -0,0 +1,87 @@
+package config
+
+func init() {
+	Register(
+		ConfigItem{
+			Name:        "chunk_size",
+			Type:        TypeInt,
+			Description: "Chunk size to consider when doing multipart requests. Specified in Mb",
+			Validator:   StrToStrPtr("minimum=8,maximum=5120"),
+			Default:     8,
+			Scope:       "object-storage",
+		},
+		ConfigItem{
+			Name:        "workers",
+			Type:        TypeInt,
+			Description: "umber of routines that spawn to do parallel operations",
+			Validator:   StrToStrPtr("minimum=1"),
+			Default:     5,
+			Scope:       "object-storage",
+		},
+		ConfigItem{
+			Name:        "default_output",
+			Type:        TypeString,
+			Description: "Default output string to be used when no other is specified",
+			Validator:   StrToStrPtr("oneof=json,json-compact,jsonl,yaml,table,csv,tsv"),
+			Default:     "json",
+		},
+		ConfigItem{
+			Name:        "region",
+			Type:        TypeString,
+			Description: "Region to reach the service",
+			Validator:   StrToStrPtr("oneof=br-se1,br-ne1,br-mgl1"),
+			Default:     "br-se1",
+		},
+		ConfigItem{
+			Name:        "env",
+			Type:        TypeString,
+			Description: "Environment",
+			Validator:   StrToStrPtr("oneof=prod,pre-prod"),
+			Default:     "prod",
+		},
+		ConfigItem{
+			Name:        "debug",
+			Type:        TypeBool,
+			Description: "Debug",
+			Default:     false,
+		},
+		ConfigItem{
+			Name:        "no_confirm",
+			Type:        TypeBool,
+			Description: "Skip confirmation prompts of destructive commands",
+			Default:     false,
+		},
+		ConfigItem{
+			Name:        "raw_output",
+			Type:        TypeBool,
+			Description: "Raw output",
+			Default:     false,
+		},
+		ConfigItem{
+			Name:        "lang",
+			Type:        TypeString,
+			Description: "Language",
+			Validator:   StrToStrPtr("oneof=en-US,pt-BR"),
+			Default:     "en-US",
+		},
+		ConfigItem{
+			Name:        "server_url",
+			Type:        TypeString,
+			Description: "Server URL. When empty, the API of the selected env is used",
+			Default:     "",
+		},
+		ConfigItem{
+			Name:        "version_last_check",
+			Type:        TypeString,
+			Description: "Last time the CLI checked for a new version",
+			Default:     "",
+		},
+		ConfigItem{
+			Name:        "credential_store",
+			Type:        TypeString,
+			Description: "Where the workspace credentials are stored. encrypted asks for a passphrase (or MGC_CREDENTIALS_PASSPHRASE)",
+			Validator:   StrToStrPtr("oneof=file,encrypted"),
+			Default:     "file",
+		},
+	)
+}
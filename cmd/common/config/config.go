@@ -1,15 +1,15 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path"
 	"reflect"
-	"strconv"
+	"slices"
 	"strings"
 
-	"github.com/magaluCloud/mgccli/cmd/common/structs"
-	"github.com/magaluCloud/mgccli/cmd/common/validator"
+	"github.com/magaluCloud/mgccli/cmd/common/filelock"
 	"github.com/magaluCloud/mgccli/cmd/common/workspace"
 	"gopkg.in/yaml.v3"
 )
@@ -21,6 +21,7 @@ type Config interface {
 	List() (map[string]*ConfigItem, error)
 	Value(name string) (Value, error)
 	Write() error
+	Override(name string, value any, source string, origin string) error
 }
 
 type ConfigItem struct {
@@ -31,132 +32,111 @@ type ConfigItem struct {
 	Validator   *string
 	Default     any
 	Scope       string
+	// Source indica de onde vem o valor efetivo (default, workspace, project,
+	// env ou flag) e Origin detalha qual arquivo, variável ou flag o definiu
+	Source string
+	Origin string
 }
 
 type CliConfig struct {
 	Items map[string]*ConfigItem
 }
 
-type ConfigYaml struct {
-	ChunkSize        int    `yaml:"chunk_size,omitempty"`
-	Workers          int    `yaml:"workers,omitempty"`
-	DefaultOutput    string `yaml:"default_output,omitempty"`
-	Region           string `yaml:"region,omitempty"`
-	Env              string `yaml:"env,omitempty"`
-	Debug            bool   `yaml:"debug,omitempty"`
-	NoConfirm        bool   `yaml:"no_confirm,omitempty"`
-	RawOutput        bool   `yaml:"raw_output,omitempty"`
-	Lang             string `yaml:"lang,omitempty"`
-	ServerURL        string `yaml:"server_url,omitempty"`
-	VersionLastCheck string `yaml:"version_last_check,omitempty"`
-}
-
 type config struct {
-	cliConfig  CliConfig
-	configYaml ConfigYaml
-	workspace  workspace.Workspace
+	cliConfig CliConfig
+	// values guarda o conteúdo do cli.yaml, inclusive chaves desconhecidas,
+	// que são preservadas ao gravar o arquivo
+	values    map[string]any
+	workspace workspace.Workspace
 }
 
 func StrToStrPtr(str string) *string {
 	return &str
 }
 
-func NewConfig(workspace workspace.Workspace) Config {
+// NewConfig carrega do cli.yaml do workspace as configs registradas com
+// Register e aplica por cima as do .mgc.yaml do projeto, quando houver
+func NewConfig(workspace workspace.Workspace, project *Project) Config {
 	configFile := path.Join(workspace.Dir(), "cli.yaml")
-	configYaml, err := structs.LoadFileToStruct[ConfigYaml](configFile)
+	values, err := readConfigFile(configFile)
 	if err != nil {
 		panic(err)
 	}
 
 	cliConfig := CliConfig{
-		Items: make(map[string]*ConfigItem, 10),
-	}
-
-	cliConfig.Items[nameToKey("chunk_size")] = &ConfigItem{
-		Name:        keyToName("chunk_size"),
-		Value:       configYaml.ChunkSize,
-		Type:        "int",
-		Description: "Chunk size to consider when doing multipart requests. Specified in Mb",
-		Validator:   StrToStrPtr("minimum=8,maximum=5120"),
-		Default:     8,
-		Scope:       "object-storage",
+		Items: make(map[string]*ConfigItem, len(registry)),
 	}
-
-	cliConfig.Items["workers"] = &ConfigItem{
-		Name:        "workers",
-		Value:       configYaml.Workers,
-		Type:        "int",
-		Description: "umber of routines that spawn to do parallel operations",
-		Validator:   StrToStrPtr("minimum=1"),
-		Default:     5,
-		Scope:       "object-storage",
+	for key, registered := range registry {
+		item := registered
+		item.Source = SourceDefault
+		cliConfig.Items[key] = &item
 	}
 
-	cliConfig.Items[nameToKey("default_output")] = &ConfigItem{
-		Name:        keyToName("default_output"),
-		Value:       configYaml.DefaultOutput,
-		Type:        "string",
-		Description: "Default output string to be used when no other is specified",
-		Validator:   StrToStrPtr("oneof=json,table"),
-		Default:     "json",
-		Scope:       "global",
+	for name, value := range values {
+		item, ok := cliConfig.Items[nameToKey(name)]
+		if !ok {
+			fmt.Fprintf(os.Stderr, "Warning: unknown config %s in %s\n", name, configFile)
+			continue
+		}
+		converted, err := convertValue(item, value)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: ignoring %s in %s: %s\n", name, configFile, err)
+			continue
+		}
+		if reflect.ValueOf(converted).IsZero() {
+			continue
+		}
+		item.Value = converted
+		item.Source = SourceWorkspace
+		item.Origin = configFile
 	}
 
-	cliConfig.Items["region"] = &ConfigItem{
-		Name:        "region",
-		Value:       configYaml.Region,
-		Type:        "string",
-		Description: "Region to reach the service",
-		Validator:   StrToStrPtr("oneof=br-se1,br-ne1,br-mgl1"),
-		Default:     "br-se1",
-		Scope:       "global",
-	}
+	c := &config{workspace: workspace, cliConfig: cliConfig, values: values}
+	c.applyProject(project)
+	return c
+}
 
-	cliConfig.Items["env"] = &ConfigItem{
-		Name:        "env",
-		Value:       configYaml.Env,
-		Type:        "string",
-		Description: "Environment",
-		Validator:   StrToStrPtr("oneof=prod,pre-prod"),
-		Default:     "prod",
-		Scope:       "global",
+// applyProject sobrescreve as configs do workspace com as do .mgc.yaml.
+// Valores inválidos são ignorados com um aviso, para não impedir o uso da CLI
+func (c *config) applyProject(project *Project) {
+	if project == nil {
+		return
 	}
-	cliConfig.Items["debug"] = &ConfigItem{
-		Name:        "debug",
-		Value:       configYaml.Debug,
-		Type:        "bool",
-		Description: "Debug",
-		Default:     false,
-		Scope:       "global",
+	for name, value := range project.Settings {
+		if _, ok := c.cliConfig.Items[nameToKey(name)]; !ok {
+			fmt.Fprintf(os.Stderr, "Warning: unknown config %s in %s\n", name, project.Path)
+			continue
+		}
+		if !slices.Contains(projectSettings, keyToName(name)) {
+			fmt.Fprintf(os.Stderr, "Warning: ignoring %s in %s: a project file can only set %s\n",
+				name, project.Path, strings.Join(projectSettings, ", "))
+			continue
+		}
+		if err := c.Override(name, value, SourceProject, project.Path); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: ignoring %s in %s: %s\n", name, project.Path, err)
+		}
 	}
+}
 
-	cliConfig.Items[nameToKey("raw_output")] = &ConfigItem{
-		Name:        keyToName("raw_output"),
-		Value:       configYaml.RawOutput,
-		Type:        "bool",
-		Description: "Raw output",
-		Default:     false,
-		Scope:       "global",
+// Override define o valor efetivo da config sem alterar o cli.yaml. O valor é
+// validado com as mesmas regras de Set e só é aplicado quando source tem
+// precedência sobre a fonte atual
+func (c *config) Override(name string, value any, source string, origin string) error {
+	item, ok := c.cliConfig.Items[nameToKey(name)]
+	if !ok {
+		return fmt.Errorf("config %s not found", name)
 	}
-	cliConfig.Items["lang"] = &ConfigItem{
-		Name:        "lang",
-		Value:       configYaml.Lang,
-		Type:        "string",
-		Description: "Language",
-		Validator:   StrToStrPtr("oneof=en-US,pt-BR"),
-		Default:     "en-US",
-		Scope:       "global",
+	converted, err := convertValue(item, value)
+	if err != nil {
+		return err
 	}
-	cliConfig.Items[nameToKey("server_url")] = &ConfigItem{
-		Name:        keyToName("server_url"),
-		Value:       configYaml.ServerURL,
-		Type:        "string",
-		Description: "Server URL",
-		Default:     "https://api.magalu.cloud",
-		Scope:       "global",
+	if sourcePrecedence[source] < sourcePrecedence[item.Source] {
+		return nil
 	}
-
-	return &config{workspace: workspace, cliConfig: cliConfig, configYaml: configYaml}
+	item.Value = converted
+	item.Source = source
+	item.Origin = origin
+	return nil
 }
 
 func (c *config) Value(name string) (Value, error) {
@@ -173,58 +153,69 @@ func (c *config) Get(name string) (*ConfigItem, error) {
 		return nil, fmt.Errorf("config %s not found", name)
 	}
 
-	if value.Value == nil {
-		value.Value = value.Default
+	// valores do projeto, do ambiente e de flags valem mesmo quando zero
+	// (ex: no_confirm: false)
+	if value.Overridden() {
 		return value, nil
 	}
-	if reflect.ValueOf(value.Value).IsZero() {
+	if value.Value == nil || reflect.ValueOf(value.Value).IsZero() {
 		value.Value = value.Default
+		value.Source = SourceDefault
+		value.Origin = ""
 		return value, nil
 	}
 	return value, nil
 }
 
+// Set grava a config no cli.yaml. Com value nil, a config é removida do
+// arquivo e volta ao valor padrão
 func (c *config) Set(name string, value any) error {
 	item, err := c.Get(name)
 	if err != nil {
 		return err
 	}
 
-	if item.Validator != nil {
-		err = validator.NewValidator(value, *item.Validator).Validate()
+	var converted any
+	if value != nil {
+		converted, err = convertValue(item, value)
 		if err != nil {
 			return err
 		}
 	}
-
-	switch item.Type {
-	case "string":
-		item.Value = anyToString(value)
-	case "int":
-		val, err := strconv.Atoi(anyToString(value))
-		if err != nil {
-			return err
-		}
-		item.Value = val
-	case "bool":
-		val, err := strconv.ParseBool(anyToString(value))
-		if err != nil {
-			return err
+	// valores do .mgc.yaml, do ambiente ou de flags continuam valendo
+	if !item.Overridden() {
+		item.Value = converted
+		item.Source = SourceWorkspace
+		item.Origin = c.filePath()
+		if converted == nil {
+			item.Value = item.Default
+			item.Source = SourceDefault
+			item.Origin = ""
 		}
-		item.Value = val
-	default:
-		return fmt.Errorf("unsupported type for config %s", name)
 	}
 
-	err = structs.Set(&c.configYaml, keyToName(name), value)
+	// relê o arquivo com o lock obtido para não desfazer configs gravadas por
+	// outros processos desde a inicialização
+	lock, err := filelock.AcquireWithTimeout(c.filePath())
 	if err != nil {
 		return err
 	}
-	err = c.Write()
+	defer lock.Release()
+
+	values, err := readConfigFile(c.filePath())
 	if err != nil {
 		return err
 	}
-	return nil
+	for key := range values {
+		if nameToKey(key) == nameToKey(name) {
+			delete(values, key)
+		}
+	}
+	if converted != nil {
+		values[keyToName(name)] = converted
+	}
+	c.values = values
+	return c.write()
 }
 
 func (c *config) Delete(name string) error {
@@ -232,21 +223,50 @@ func (c *config) Delete(name string) error {
 }
 
 func (c *config) Write() error {
-	data, err := yaml.Marshal(c.configYaml)
+	lock, err := filelock.AcquireWithTimeout(c.filePath())
 	if err != nil {
 		return err
 	}
-	err = os.WriteFile(path.Join(c.workspace.Dir(), "cli.yaml"), data, 0644)
+	defer lock.Release()
+	return c.write()
+}
+
+func (c *config) write() error {
+	data, err := yaml.Marshal(c.values)
 	if err != nil {
 		return err
 	}
-	return nil
+	return workspace.WriteFileAtomic(c.filePath(), data, workspace.FILE_PERMISSION)
+}
+
+func (c *config) filePath() string {
+	return path.Join(c.workspace.Dir(), "cli.yaml")
 }
 
 func (c *config) List() (map[string]*ConfigItem, error) {
 	return c.cliConfig.Items, nil
 }
 
+// readConfigFile lê o cli.yaml como um mapa. Um arquivo inexistente equivale
+// a um arquivo vazio
+func readConfigFile(filePath string) (map[string]any, error) {
+	values := map[string]any{}
+	data, err := os.ReadFile(filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return values, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(data, &values); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", filePath, err)
+	}
+	if values == nil {
+		values = map[string]any{}
+	}
+	return values, nil
+}
+
 // name_to_key -> name-to-key
 func nameToKey(name string) string {
 	return strings.ToLower(strings.ReplaceAll(name, "_", "-"))
@@ -0,0 +1,149 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const ProjectFileName = ".mgc.yaml"
+
+// projectSettings são as configs que um .mgc.yaml pode sobrescrever. O
+// arquivo é procurado em todos os diretórios acima do atual e pode vir de um
+// repositório clonado, então configs como server_url, env, credential_store
+// e no_confirm só são aceitas no cli.yaml e no ambiente
+var projectSettings = []string{"region", "default_output"}
+
+// Project é o .mgc.yaml de um repositório: fixa o workspace, sobrescreve
+// as configs region e default_output do cli.yaml e define valores padrão
+// para flags de comandos específicos
+//
+//	workspace: tenant-a
+//	region: br-ne1
+//	defaults:
+//	  network vpcs list:
+//	    limit: 10
+type Project struct {
+	Path      string
+	Workspace string
+	Settings  map[string]any
+	Defaults  map[string]map[string]string
+}
+
+// FindProject procura o .mgc.yaml a partir de dir subindo até a raiz.
+// Retorna nil quando nenhum arquivo é encontrado
+func FindProject(dir string) (*Project, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		filePath := filepath.Join(dir, ProjectFileName)
+		if info, err := os.Stat(filePath); err == nil && !info.IsDir() {
+			return LoadProject(filePath)
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return nil, nil
+		}
+		dir = parent
+	}
+}
+
+// LoadProject lê um .mgc.yaml
+func LoadProject(filePath string) (*Project, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	content := map[string]any{}
+	if err := yaml.Unmarshal(data, &content); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", filePath, err)
+	}
+
+	project := &Project{
+		Path:     filePath,
+		Settings: map[string]any{},
+		Defaults: map[string]map[string]string{},
+	}
+	for key, value := range content {
+		switch key {
+		case "workspace":
+			project.Workspace = anyToString(value)
+		case "defaults":
+			commands, ok := value.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("invalid %s: defaults must map command paths to flags", filePath)
+			}
+			for command, flags := range commands {
+				flagValues, ok := flags.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("invalid %s: defaults of %q must map flag names to values", filePath, command)
+				}
+				path := strings.Join(strings.Fields(command), " ")
+				project.Defaults[path] = map[string]string{}
+				for flag, flagValue := range flagValues {
+					project.Defaults[path][strings.TrimLeft(flag, "-")] = flagString(flagValue)
+				}
+			}
+		default:
+			project.Settings[key] = value
+		}
+	}
+	return project, nil
+}
+
+// FlagDefaults retorna os valores padrão das flags do comando (ex: "network
+// vpcs list"), incluindo os definidos para os comandos pais. O caminho mais
+// específico tem precedência
+func (p *Project) FlagDefaults(commandPath string) map[string]string {
+	result := map[string]string{}
+	if p == nil {
+		return result
+	}
+
+	paths := make([]string, 0, len(p.Defaults))
+	for path := range p.Defaults {
+		if path == commandPath || strings.HasPrefix(commandPath, path+" ") {
+			paths = append(paths, path)
+		}
+	}
+	sort.Slice(paths, func(i, j int) bool { return len(paths[i]) < len(paths[j]) })
+
+	for _, path := range paths {
+		for flag, value := range p.Defaults[path] {
+			result[flag] = value
+		}
+	}
+	return result
+}
+
+// flagString converte o valor do YAML para o formato aceito na linha de
+// comando: listas separadas por vírgula e objetos como JSON
+func flagString(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case []any:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			items = append(items, flagString(item))
+		}
+		return strings.Join(items, ",")
+	case map[string]any:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(data)
+	case string, int, bool, float64:
+		return anyToString(v)
+	}
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,110 @@
+package config
+
+import (
+	"maps"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFindProject(t *testing.T) {
+	root := t.TempDir()
+	nested := filepath.Join(root, "services", "api", "deploy")
+	if err := os.MkdirAll(nested, 0700); err != nil {
+		t.Fatal(err)
+	}
+	content := "workspace: tenant-a\nregion: br-ne1\ndefaults:\n  network vpcs list:\n    --limit: 10\n"
+	if err := os.WriteFile(filepath.Join(root, "services", ProjectFileName), []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	project, err := FindProject(nested)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if project == nil {
+		t.Fatal("project not found from a nested directory")
+	}
+	if want := filepath.Join(root, "services", ProjectFileName); project.Path != want {
+		t.Errorf("path = %s, want %s", project.Path, want)
+	}
+	if project.Workspace != "tenant-a" || project.Settings["region"] != "br-ne1" {
+		t.Errorf("got workspace %q and settings %v", project.Workspace, project.Settings)
+	}
+	if got := project.Defaults["network vpcs list"]["limit"]; got != "10" {
+		t.Errorf("limit default = %q, want %q", got, "10")
+	}
+
+	// diretórios fora do projeto não herdam o arquivo
+	if project, err := FindProject(root); err != nil || project != nil {
+		t.Errorf("FindProject(root) = %v, %v, want nil", project, err)
+	}
+}
+
+func TestFindProjectInvalidFile(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, ProjectFileName), []byte("defaults: [a, b]\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := FindProject(dir); err == nil {
+		t.Fatal("expected an error for invalid defaults")
+	}
+}
+
+func TestFlagDefaults(t *testing.T) {
+	project := &Project{Defaults: map[string]map[string]string{
+		"network":           {"limit": "5", "region": "br-ne1"},
+		"network vpcs":      {"limit": "20"},
+		"network vpcs list": {"limit": "10", "sort": "name"},
+		"network ports":     {"limit": "30"},
+		"net":               {"debug": "true"},
+	}}
+
+	tests := []struct {
+		name        string
+		commandPath string
+		expected    map[string]string
+	}{
+		{name: "most specific path wins", commandPath: "network vpcs list", expected: map[string]string{"limit": "10", "region": "br-ne1", "sort": "name"}},
+		{name: "inherits from parents", commandPath: "network vpcs create", expected: map[string]string{"limit": "20", "region": "br-ne1"}},
+		{name: "exact parent", commandPath: "network", expected: map[string]string{"limit": "5", "region": "br-ne1"}},
+		{name: "no match", commandPath: "compute instances list", expected: map[string]string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := project.FlagDefaults(tt.commandPath); !maps.Equal(got, tt.expected) {
+				t.Errorf("got %v, want %v", got, tt.expected)
+			}
+		})
+	}
+
+	var none *Project
+	if got := none.FlagDefaults("network"); len(got) != 0 {
+		t.Errorf("nil project returned %v", got)
+	}
+}
+
+func TestProjectOnlyOverridesAllowedSettings(t *testing.T) {
+	project := &Project{Path: ".mgc.yaml", Settings: map[string]any{
+		"region":           "br-ne1",
+		"default-output":   "table",
+		"server_url":       "https://attacker.example",
+		"env":              "pre-prod",
+		"credential_store": "file",
+		"no_confirm":       true,
+	}}
+	cfg := newTestConfig(t, "", project)
+
+	for name, expected := range map[string]any{"region": "br-ne1", "default_output": "table"} {
+		item, _ := cfg.Get(name)
+		if item.Value != expected || item.Source != SourceProject {
+			t.Errorf("%s = %v (%s), want %v (project)", name, item.Value, item.Source, expected)
+		}
+	}
+	for _, name := range []string{"server_url", "env", "credential_store", "no_confirm"} {
+		if item, _ := cfg.Get(name); item.Source == SourceProject {
+			t.Errorf("%s was overridden by the project file with %v", name, item.Value)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Tipos de config suportados
+const (
+	TypeString = "string"
+	TypeInt    = "int"
+	TypeBool   = "bool"
+	TypeList   = "list"
+	TypeMap    = "map"
+
+	ScopeGlobal = "global"
+)
+
+var types = []string{TypeString, TypeInt, TypeBool, TypeList, TypeMap}
+
+var registry = map[string]ConfigItem{}
+
+// Register declara configs que podem ser lidas e gravadas no cli.yaml. Deve
+// ser chamado no init() do pacote dono da config, por exemplo:
+//
+//	config.Register(config.ConfigItem{
+//		Name:        "default_vpc",
+//		Type:        config.TypeString,
+//		Description: "VPC used by network commands when none is given",
+//		Scope:       "network",
+//	})
+//
+// Nomes repetidos e tipos desconhecidos são erros de programação e causam panic
+func Register(items ...ConfigItem) {
+	for _, item := range items {
+		key := nameToKey(item.Name)
+		if _, ok := registry[key]; ok {
+			panic(fmt.Sprintf("config %s already registered", item.Name))
+		}
+		if !slices.Contains(types, item.Type) {
+			panic(fmt.Sprintf("config %s has unsupported type %q", item.Name, item.Type))
+		}
+		if item.Scope == "" {
+			item.Scope = ScopeGlobal
+		}
+		item.Name = keyToName(item.Name)
+		item.Value = nil
+		registry[key] = item
+	}
+}
+
+// Scopes retorna os escopos das configs registradas, ordenados
+func Scopes() []string {
+	scopes := []string{}
+	for _, item := range registry {
+		if !slices.Contains(scopes, item.Scope) {
+			scopes = append(scopes, item.Scope)
+		}
+	}
+	sort.Strings(scopes)
+	return scopes
+}
+
+// Options retorna os valores aceitos por uma config com validator oneof (ex:
+// as regiões da config region). Retorna nil para as demais configs
+func Options(name string) []string {
+	item, ok := registry[nameToKey(name)]
+	if !ok || item.Validator == nil {
+		return nil
+	}
+	_, values, ok := strings.Cut(*item.Validator, "oneof=")
+	if !ok {
+		return nil
+	}
+	return strings.Split(values, ",")
+}
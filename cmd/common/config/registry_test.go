@@ -0,0 +1,62 @@
+package config
+
+import (
+	"slices"
+	"testing"
+)
+
+// registerForTest registra item e o remove do registro ao fim do teste
+func registerForTest(t *testing.T, item ConfigItem) {
+	t.Helper()
+	Register(item)
+	t.Cleanup(func() { delete(registry, nameToKey(item.Name)) })
+}
+
+func expectPanic(t *testing.T, name string, f func()) {
+	t.Helper()
+	defer func() {
+		if recover() == nil {
+			t.Errorf("%s: expected a panic", name)
+		}
+	}()
+	f()
+}
+
+func TestRegister(t *testing.T) {
+	registerForTest(t, ConfigItem{Name: "test_register", Type: TypeString, Default: "a"})
+
+	item, ok := registry[nameToKey("test-register")]
+	if !ok {
+		t.Fatal("config not registered")
+	}
+	if item.Name != "test_register" || item.Scope != ScopeGlobal {
+		t.Errorf("got name %q and scope %q", item.Name, item.Scope)
+	}
+
+	expectPanic(t, "duplicate name", func() {
+		Register(ConfigItem{Name: "test-register", Type: TypeString})
+	})
+	expectPanic(t, "builtin name", func() {
+		Register(ConfigItem{Name: "region", Type: TypeString})
+	})
+	expectPanic(t, "unknown type", func() {
+		Register(ConfigItem{Name: "test_register_float", Type: "float"})
+	})
+	if _, ok := registry[nameToKey("test_register_float")]; ok {
+		t.Error("config with unknown type was registered")
+	}
+}
+
+func TestScopesAndOptions(t *testing.T) {
+	registerForTest(t, ConfigItem{Name: "test_scoped", Type: TypeString, Scope: "test-scope", Validator: StrToStrPtr("oneof=x,y")})
+
+	if !slices.Contains(Scopes(), "test-scope") || !slices.Contains(Scopes(), ScopeGlobal) {
+		t.Errorf("Scopes() = %v", Scopes())
+	}
+	if got := Options("test-scoped"); !slices.Equal(got, []string{"x", "y"}) {
+		t.Errorf("Options() = %v, want [x y]", got)
+	}
+	if got := Options("debug"); got != nil {
+		t.Errorf("Options(debug) = %v, want nil", got)
+	}
+}
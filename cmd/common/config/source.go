@@ -0,0 +1,68 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Fontes do valor efetivo de uma config, da menor para a maior precedência
+const (
+	SourceDefault   = "default"
+	SourceWorkspace = "workspace"
+	SourceProject   = "project"
+	SourceEnv       = "env"
+	SourceFlag      = "flag"
+
+	EnvPrefix = "MGC_"
+)
+
+var sourcePrecedence = map[string]int{
+	SourceDefault:   0,
+	SourceWorkspace: 1,
+	SourceProject:   2,
+	SourceEnv:       3,
+	SourceFlag:      4,
+}
+
+// Overridden informa se o valor vem de uma fonte que tem precedência sobre o
+// cli.yaml, e por isso vale mesmo quando zero (ex: MGC_NO_CONFIRM=false)
+func (i *ConfigItem) Overridden() bool {
+	return sourcePrecedence[i.Source] > sourcePrecedence[SourceWorkspace]
+}
+
+// EnvName retorna a variável de ambiente que sobrescreve a config
+// (ex: default_output -> MGC_DEFAULT_OUTPUT)
+func EnvName(name string) string {
+	return EnvPrefix + strings.ToUpper(keyToName(name))
+}
+
+// ApplyEnv sobrescreve as configs com as variáveis MGC_<NOME> definidas.
+// Ao contrário do .mgc.yaml, valores inválidos são erros: em um pipeline é
+// melhor falhar do que usar outra região sem perceber
+func ApplyEnv(cfg Config) error {
+	items, err := cfg.List()
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(items))
+	for name := range items {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []error
+	for _, name := range names {
+		env := EnvName(name)
+		value, ok := os.LookupEnv(env)
+		if !ok || value == "" {
+			continue
+		}
+		if err := cfg.Override(name, value, SourceEnv, env); err != nil {
+			errs = append(errs, fmt.Errorf("invalid value %q in %s: %w", value, env, err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,137 @@
+package config
+
+import (
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/magaluCloud/mgccli/cmd/common/workspace"
+)
+
+// newTestConfig cria a config de um workspace temporário com o cli.yaml informado
+func newTestConfig(t *testing.T, cliYAML string, project *Project) Config {
+	t.Helper()
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	ws := workspace.NewWorkspace().Current()
+	if err := os.MkdirAll(ws.Dir(), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path.Join(ws.Dir(), "cli.yaml"), []byte(cliYAML), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return NewConfig(ws, project)
+}
+
+func TestSourcePrecedence(t *testing.T) {
+	tests := []struct {
+		name           string
+		workspace      string
+		project        string
+		env            string
+		flag           string
+		expectedValue  string
+		expectedSource string
+	}{
+		{name: "default", expectedValue: "br-se1", expectedSource: SourceDefault},
+		{name: "workspace", workspace: "br-ne1", expectedValue: "br-ne1", expectedSource: SourceWorkspace},
+		{name: "project over workspace", workspace: "br-ne1", project: "br-mgl1", expectedValue: "br-mgl1", expectedSource: SourceProject},
+		{name: "env over project", workspace: "br-ne1", project: "br-mgl1", env: "br-se1", expectedValue: "br-se1", expectedSource: SourceEnv},
+		{name: "flag over env", project: "br-mgl1", env: "br-se1", flag: "br-ne1", expectedValue: "br-ne1", expectedSource: SourceFlag},
+		{name: "flag only", flag: "br-mgl1", expectedValue: "br-mgl1", expectedSource: SourceFlag},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cliYAML := ""
+			if tt.workspace != "" {
+				cliYAML = "region: " + tt.workspace + "\n"
+			}
+			var project *Project
+			if tt.project != "" {
+				project = &Project{Path: ".mgc.yaml", Settings: map[string]any{"region": tt.project}}
+			}
+			cfg := newTestConfig(t, cliYAML, project)
+
+			t.Setenv(EnvName("region"), tt.env)
+			if err := ApplyEnv(cfg); err != nil {
+				t.Fatal(err)
+			}
+			if tt.flag != "" {
+				if err := cfg.Override("region", tt.flag, SourceFlag, "--region"); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			item, err := cfg.Get("region")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if item.Value != tt.expectedValue || item.Source != tt.expectedSource {
+				t.Errorf("got %v (%s), want %s (%s)", item.Value, item.Source, tt.expectedValue, tt.expectedSource)
+			}
+		})
+	}
+}
+
+func TestOverrideKeepsHigherSource(t *testing.T) {
+	cfg := newTestConfig(t, "", nil)
+	if err := cfg.Override("region", "br-ne1", SourceFlag, "--region"); err != nil {
+		t.Fatal(err)
+	}
+	if err := cfg.Override("region", "br-mgl1", SourceEnv, "MGC_REGION"); err != nil {
+		t.Fatal(err)
+	}
+
+	item, _ := cfg.Get("region")
+	if item.Value != "br-ne1" || item.Source != SourceFlag || item.Origin != "--region" {
+		t.Errorf("got %v (%s: %s), want br-ne1 (flag: --region)", item.Value, item.Source, item.Origin)
+	}
+}
+
+func TestOverrideWithZeroValue(t *testing.T) {
+	cfg := newTestConfig(t, "no_confirm: true\n", nil)
+	t.Setenv(EnvName("no_confirm"), "false")
+	if err := ApplyEnv(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	value, err := cfg.Value("no_confirm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value.Bool() {
+		t.Error("MGC_NO_CONFIRM=false did not override the workspace value")
+	}
+}
+
+func TestApplyEnvRejectsInvalidValues(t *testing.T) {
+	tests := []struct {
+		name  string
+		env   string
+		value string
+	}{
+		{name: "unknown region", env: "region", value: "br-xx"},
+		{name: "bool", env: "no_confirm", value: "ture"},
+		{name: "int", env: "workers", value: "many"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := newTestConfig(t, "", nil)
+			before, _ := cfg.Get(tt.env)
+			beforeValue, beforeSource := before.Value, before.Source
+
+			t.Setenv(EnvName(tt.env), tt.value)
+			err := ApplyEnv(cfg)
+			if err == nil || !strings.Contains(err.Error(), EnvName(tt.env)) {
+				t.Fatalf("got %v, want an error naming %s", err, EnvName(tt.env))
+			}
+
+			after, _ := cfg.Get(tt.env)
+			if after.Value != beforeValue || after.Source != beforeSource {
+				t.Errorf("invalid value applied: got %v (%s)", after.Value, after.Source)
+			}
+		})
+	}
+}
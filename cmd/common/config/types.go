@@ -0,0 +1,158 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/magaluCloud/mgccli/cmd/common/validator"
+)
+
+// convertValue converte value para o tipo da config e o valida. Além dos
+// valores do YAML, aceita texto vindo da linha de comando ou do ambiente:
+// listas separadas por vírgula (a,b) e mapas como chave=valor (a=1,b=2) ou JSON
+func convertValue(item *ConfigItem, value any) (any, error) {
+	var converted any
+	var err error
+	switch item.Type {
+	case TypeString:
+		converted = anyToString(value)
+	case TypeInt:
+		converted, err = toInt(value)
+	case TypeBool:
+		converted, err = toBool(value)
+	case TypeList:
+		converted, err = toList(value)
+	case TypeMap:
+		converted, err = toMap(value)
+	default:
+		return nil, fmt.Errorf("unsupported type for config %s", item.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if item.Validator != nil {
+		if err := validate(converted, *item.Validator); err != nil {
+			return nil, err
+		}
+	}
+	return converted, nil
+}
+
+// validate aplica o validator ao valor ou, em listas e mapas, a cada item
+func validate(value any, tag string) error {
+	switch v := value.(type) {
+	case []string:
+		for _, item := range v {
+			if err := validator.NewValidator(item, tag).Validate(); err != nil {
+				return err
+			}
+		}
+		return nil
+	case map[string]string:
+		for _, item := range v {
+			if err := validator.NewValidator(item, tag).Validate(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return validator.NewValidator(value, tag).Validate()
+}
+
+func toInt(value any) (int, error) {
+	if v, ok := value.(int); ok {
+		return v, nil
+	}
+	converted, err := strconv.Atoi(anyToString(value))
+	if err != nil {
+		return 0, fmt.Errorf("value %v must be an integer", value)
+	}
+	return converted, nil
+}
+
+func toBool(value any) (bool, error) {
+	if v, ok := value.(bool); ok {
+		return v, nil
+	}
+	converted, err := strconv.ParseBool(anyToString(value))
+	if err != nil {
+		return false, fmt.Errorf("value %v must be true or false", value)
+	}
+	return converted, nil
+}
+
+func toList(value any) ([]string, error) {
+	switch v := value.(type) {
+	case []string:
+		return v, nil
+	case []any:
+		list := make([]string, 0, len(v))
+		for _, item := range v {
+			list = append(list, anyToString(item))
+		}
+		return list, nil
+	case string:
+		list := []string{}
+		for _, item := range strings.Split(v, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				list = append(list, item)
+			}
+		}
+		return list, nil
+	}
+	return nil, fmt.Errorf("value %v must be a list", value)
+}
+
+func toMap(value any) (map[string]string, error) {
+	switch v := value.(type) {
+	case map[string]string:
+		return v, nil
+	case map[string]any:
+		result := make(map[string]string, len(v))
+		for key, item := range v {
+			result[key] = anyToString(item)
+		}
+		return result, nil
+	case string:
+		v = strings.TrimSpace(v)
+		if strings.HasPrefix(v, "{") {
+			content := map[string]any{}
+			if err := json.Unmarshal([]byte(v), &content); err != nil {
+				return nil, fmt.Errorf("value %s must be a JSON object: %w", v, err)
+			}
+			return toMap(content)
+		}
+		result := map[string]string{}
+		for _, pair := range strings.Split(v, ",") {
+			if pair = strings.TrimSpace(pair); pair == "" {
+				continue
+			}
+			key, item, ok := strings.Cut(pair, "=")
+			if !ok || strings.TrimSpace(key) == "" {
+				return nil, fmt.Errorf("value %s must be key=value pairs separated by commas", v)
+			}
+			result[strings.TrimSpace(key)] = strings.TrimSpace(item)
+		}
+		return result, nil
+	}
+	return nil, fmt.Errorf("value %v must be a map", value)
+}
+
+// mapToString formata o mapa como chave=valor ordenado pelas chaves
+func mapToString(value map[string]string) string {
+	keys := make([]string, 0, len(value))
+	for key := range value {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, key+"="+value[key])
+	}
+	return strings.Join(pairs, ",")
+}
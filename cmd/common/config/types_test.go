@@ -0,0 +1,151 @@
+package config
+
+import (
+	"io"
+	"maps"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestToList(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    any
+		expected []string
+		invalid  bool
+	}{
+		{name: "comma separated", value: "a, b,,c ", expected: []string{"a", "b", "c"}},
+		{name: "empty string", value: "", expected: []string{}},
+		{name: "yaml list", value: []any{"a", 1, true}, expected: []string{"a", "1", "true"}},
+		{name: "string slice", value: []string{"a"}, expected: []string{"a"}},
+		{name: "map", value: map[string]any{"a": 1}, invalid: true},
+		{name: "number", value: 1, invalid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := toList(tt.value)
+			if (err != nil) != tt.invalid {
+				t.Fatalf("unexpected error state: %v", err)
+			}
+			if !tt.invalid && !slices.Equal(got, tt.expected) {
+				t.Errorf("got %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestToMap(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    any
+		expected map[string]string
+		invalid  bool
+	}{
+		{name: "key=value pairs", value: "a=1, b = 2,", expected: map[string]string{"a": "1", "b": "2"}},
+		{name: "value with equals", value: "query=a=b", expected: map[string]string{"query": "a=b"}},
+		{name: "empty value", value: "a=", expected: map[string]string{"a": ""}},
+		{name: "json", value: `{"a": 1, "b": "x"}`, expected: map[string]string{"a": "1", "b": "x"}},
+		{name: "yaml map", value: map[string]any{"a": 1, "b": false}, expected: map[string]string{"a": "1", "b": "false"}},
+		{name: "string map", value: map[string]string{"a": "1"}, expected: map[string]string{"a": "1"}},
+		{name: "missing equals", value: "a=1,b", invalid: true},
+		{name: "missing key", value: "=1", invalid: true},
+		{name: "invalid json", value: `{"a":`, invalid: true},
+		{name: "list", value: []any{"a"}, invalid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := toMap(tt.value)
+			if (err != nil) != tt.invalid {
+				t.Fatalf("unexpected error state: %v", err)
+			}
+			if !tt.invalid && !maps.Equal(got, tt.expected) {
+				t.Errorf("got %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestConvertValueValidatesEachElement(t *testing.T) {
+	list := &ConfigItem{Name: "zones", Type: TypeList, Validator: StrToStrPtr("oneof=a,b")}
+	if _, err := convertValue(list, "a,b"); err != nil {
+		t.Errorf("valid list: %v", err)
+	}
+	if _, err := convertValue(list, []any{"a", "c"}); err == nil {
+		t.Error("expected an error for an invalid list element")
+	}
+
+	tags := &ConfigItem{Name: "tags", Type: TypeMap, Validator: StrToStrPtr("oneof=a,b")}
+	if _, err := convertValue(tags, "x=a,y=b"); err != nil {
+		t.Errorf("valid map: %v", err)
+	}
+	if _, err := convertValue(tags, map[string]any{"x": "a", "y": "c"}); err == nil {
+		t.Error("expected an error for an invalid map value")
+	}
+
+	region := &ConfigItem{Name: "region", Type: TypeString, Validator: StrToStrPtr("oneof=br-se1,br-ne1")}
+	if _, err := convertValue(region, "br-xx"); err == nil {
+		t.Error("expected an error for an invalid string")
+	}
+}
+
+// captureStderr retorna o que f escreveu em os.Stderr
+func captureStderr(t *testing.T, f func()) string {
+	t.Helper()
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	previous := os.Stderr
+	os.Stderr = writer
+	defer func() { os.Stderr = previous }()
+
+	f()
+	writer.Close()
+	data, _ := io.ReadAll(reader)
+	return string(data)
+}
+
+func TestNewConfigWarnsUnknownKeys(t *testing.T) {
+	output := captureStderr(t, func() {
+		newTestConfig(t, "region: br-ne1\nfuture_setting: x\nworkers: many\n", nil)
+	})
+	if !strings.Contains(output, "unknown config future_setting") {
+		t.Errorf("missing unknown config warning in %q", output)
+	}
+	if !strings.Contains(output, "ignoring workers") {
+		t.Errorf("missing invalid value warning in %q", output)
+	}
+}
+
+func TestSetKeepsUnknownKeys(t *testing.T) {
+	var cfg Config
+	captureStderr(t, func() {
+		cfg = newTestConfig(t, "region: br-ne1\nfuture_setting: x\nfuture_list: [a, b]\n", nil)
+	})
+	if err := cfg.Set("default-output", "table"); err != nil {
+		t.Fatal(err)
+	}
+	if err := cfg.Delete("region"); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(path.Join(cfg.(*config).workspace.Dir(), "cli.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := map[string]any{}
+	if err := yaml.Unmarshal(data, &content); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]any{"default_output": "table", "future_setting": "x", "future_list": []any{"a", "b"}}
+	if len(content) != len(expected) || content["default_output"] != "table" || content["future_setting"] != "x" || !slices.Equal(content["future_list"].([]any), []any{"a", "b"}) {
+		t.Errorf("cli.yaml = %v, want %v", content, expected)
+	}
+}
@@ -1,11 +1,16 @@
 package config
 
-import "strconv"
+import (
+	"strconv"
+	"strings"
+)
 
 type Value interface {
 	String() string
 	Bool() bool
 	Int() int
+	List() []string
+	Map() map[string]string
 }
 
 type valuePtr struct {
@@ -35,6 +40,15 @@ func (v *valuePtr) Int() int {
 	return v.value.(int)
 }
 
+func (v *valuePtr) List() []string {
+	list, _ := v.value.([]string)
+	return list
+}
+func (v *valuePtr) Map() map[string]string {
+	m, _ := v.value.(map[string]string)
+	return m
+}
+
 func anyToString(value any) string {
 	switch v := value.(type) {
 	case string:
@@ -45,6 +59,10 @@ func anyToString(value any) string {
 		return strconv.FormatBool(v)
 	case float64:
 		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []string:
+		return strings.Join(v, ",")
+	case map[string]string:
+		return mapToString(v)
 	}
 	return ""
 }
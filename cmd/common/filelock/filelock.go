@@ -0,0 +1,78 @@
+package filelock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	// DefaultTimeout é o tempo máximo de espera por um lock mantido por outro processo
+	DefaultTimeout = 30 * time.Second
+
+	retryInterval = 50 * time.Millisecond
+	lockSuffix    = ".lock"
+	filePerm      = 0600
+	dirPerm       = 0700
+)
+
+var errLocked = errors.New("file is locked by another process")
+
+// Lock é um lock exclusivo entre processos sobre um arquivo, feito em um
+// arquivo auxiliar <arquivo>.lock que permanece no disco
+type Lock struct {
+	file *os.File
+}
+
+// Acquire bloqueia path para escrita, esperando até que outro processo o
+// libere ou até o fim do contexto
+func Acquire(ctx context.Context, path string) (*Lock, error) {
+	if err := os.MkdirAll(filepath.Dir(path), dirPerm); err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(path+lockSuffix, os.O_CREATE|os.O_RDWR, filePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		err := tryLock(file)
+		if err == nil {
+			return &Lock{file: file}, nil
+		}
+		if !errors.Is(err, errLocked) {
+			file.Close()
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			file.Close()
+			return nil, fmt.Errorf("timed out waiting for the lock on %s: %w", path, ctx.Err())
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+// AcquireWithTimeout é Acquire com o tempo de espera padrão
+func AcquireWithTimeout(path string) (*Lock, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+	return Acquire(ctx, path)
+}
+
+// Release libera o lock
+func (l *Lock) Release() error {
+	if l == nil || l.file == nil {
+		return nil
+	}
+	err := unlock(l.file)
+	if closeErr := l.file.Close(); err == nil {
+		err = closeErr
+	}
+	l.file = nil
+	return err
+}
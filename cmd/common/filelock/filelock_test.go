@@ -0,0 +1,105 @@
+package filelock
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAcquireIsExclusive(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "auth.yaml")
+	lock, err := Acquire(context.Background(), path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if _, err := Acquire(ctx, path); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want a timeout while the lock is held", err)
+	}
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Errorf("gave up after %s, before the timeout", elapsed)
+	}
+
+	if err := lock.Release(); err != nil {
+		t.Fatal(err)
+	}
+	second, err := Acquire(context.Background(), path)
+	if err != nil {
+		t.Fatalf("lock not available after release: %v", err)
+	}
+	second.Release()
+}
+
+func TestAcquireWaitsForRelease(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "auth.yaml")
+	lock, err := Acquire(context.Background(), path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		lock.Release()
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	second, err := Acquire(ctx, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second.Release()
+}
+
+func TestAcquireSerializesUpdates(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "counter")
+	if err := os.WriteFile(path, []byte("0"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	// sem o lock, leituras e escritas intercaladas perderiam incrementos
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			lock, err := AcquireWithTimeout(path)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			defer lock.Release()
+
+			data, _ := os.ReadFile(path)
+			count, _ := strconv.Atoi(string(data))
+			time.Sleep(5 * time.Millisecond)
+			os.WriteFile(path, []byte(strconv.Itoa(count+1)), 0600)
+		}()
+	}
+	wg.Wait()
+
+	data, _ := os.ReadFile(path)
+	if string(data) != "10" {
+		t.Errorf("counter = %s, want 10", data)
+	}
+}
+
+func TestReleaseTwice(t *testing.T) {
+	lock, err := Acquire(context.Background(), filepath.Join(t.TempDir(), "auth.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := lock.Release(); err != nil {
+		t.Fatal(err)
+	}
+	if err := lock.Release(); err != nil {
+		t.Errorf("second release: %v", err)
+	}
+}
@@ -0,0 +1,252 @@
+package workspace
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	archiveDirName = ".archive"
+	configFileName = "cli.yaml"
+	maxImportSize  = 10 << 20
+)
+
+// credentialFiles são os arquivos de credenciais (texto puro e cifrado),
+// exportados apenas quando pedido explicitamente
+var credentialFiles = []string{"auth.yaml", "auth.yaml.age"}
+
+var errorArchiveEmpty = errors.New("archive does not contain any workspace file")
+
+// Rename renomeia o workspace, mantendo-o selecionado quando for o atual
+func (w *workspace) Rename(source string, target string) error {
+	if err := checkWorkspaceName(w.dirConfig, source); err != nil {
+		return err
+	}
+	if err := w.checkNewName(target); err != nil {
+		return err
+	}
+	if err := os.Rename(path.Join(w.dirConfig, source), path.Join(w.dirConfig, target)); err != nil {
+		return err
+	}
+	if w.selected() == source {
+		return w.Set(target)
+	}
+	return nil
+}
+
+// Export grava em out um tar.gz com a configuração do workspace e, com
+// includeCredentials, as credenciais. Caches e locks não são exportados
+func (w *workspace) Export(name string, out io.Writer, includeCredentials bool) error {
+	if err := checkWorkspaceName(w.dirConfig, name); err != nil {
+		return err
+	}
+	files := []string{configFileName}
+	if includeCredentials {
+		files = append(files, credentialFiles...)
+	}
+	return writeArchive(out, path.Join(w.dirConfig, name), name, func(rel string) bool {
+		return slices.Contains(files, rel)
+	})
+}
+
+// Import cria o workspace name com os arquivos de um arquivo gerado por
+// Export. Quando name é vazio, usa o nome gravado no arquivo. Com overwrite,
+// os arquivos importados substituem os de um workspace existente
+func (w *workspace) Import(name string, in io.Reader, overwrite bool) (string, error) {
+	files, archivedName, err := readArchive(in)
+	if err != nil {
+		return "", err
+	}
+	if name == "" {
+		name = archivedName
+	}
+	if name == "" {
+		return "", errors.New("workspace name is required")
+	}
+
+	if err := w.checkNewName(name); err != nil {
+		if !overwrite || !errors.Is(err, errorWorkspaceAlreadyExists) {
+			return "", err
+		}
+	}
+
+	dir := path.Join(w.dirConfig, name)
+	for file, data := range files {
+		perm := os.FileMode(FILE_PERMISSION)
+		if slices.Contains(credentialFiles, file) {
+			perm = 0600
+		}
+		if err := WriteFileAtomic(path.Join(dir, file), data, perm); err != nil {
+			return "", err
+		}
+	}
+	return name, nil
+}
+
+// Archive salva uma cópia completa do workspace em <config>/.archive e
+// retorna o caminho do arquivo criado
+func (w *workspace) Archive(name string) (string, error) {
+	if err := checkWorkspaceName(w.dirConfig, name); err != nil {
+		return "", err
+	}
+	archiveDir := path.Join(w.dirConfig, archiveDirName)
+	if err := os.MkdirAll(archiveDir, DIR_PERMISSION); err != nil {
+		return "", err
+	}
+
+	archivePath := path.Join(archiveDir, fmt.Sprintf("%s-%s.tar.gz", name, time.Now().Format("20060102-150405")))
+	file, err := os.OpenFile(archivePath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return "", err
+	}
+	err = writeArchive(file, path.Join(w.dirConfig, name), name, func(string) bool { return true })
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(archivePath)
+		return "", err
+	}
+	return archivePath, nil
+}
+
+// DeleteAll remove o workspace e todo o seu conteúdo
+func (w *workspace) DeleteAll(name string) error {
+	if err := checkWorkspaceName(w.dirConfig, name); err != nil {
+		return err
+	}
+	if w.selected() == name {
+		return errorDeleteCurrentNotAllowed
+	}
+	return os.RemoveAll(path.Join(w.dirConfig, name))
+}
+
+// checkNewName valida o nome de um workspace que será criado
+func (w *workspace) checkNewName(name string) error {
+	if name == currentWorkspaceNameFile {
+		return errorNameNotAllowed
+	}
+	if !isWorkspaceNameValid(name) || strings.ContainsAny(name, `./\`) {
+		return errorInvalidName
+	}
+	if _, err := os.Stat(path.Join(w.dirConfig, name)); err == nil {
+		return errorWorkspaceAlreadyExists
+	}
+	return nil
+}
+
+// selected retorna o workspace gravado no arquivo current, ignorando a
+// seleção feita só para esta execução
+func (w *workspace) selected() string {
+	data, err := read(path.Join(w.dirConfig, currentWorkspaceNameFile))
+	if err != nil || len(data) == 0 {
+		return defaultWorkspaceName
+	}
+	return string(data)
+}
+
+// writeArchive grava em out os arquivos regulares de dir aceitos por include,
+// dentro de um diretório name no tar.gz
+func writeArchive(out io.Writer, dir string, name string, include func(rel string) bool) error {
+	gz := gzip.NewWriter(out)
+	tw := tar.NewWriter(gz)
+
+	err := filepath.WalkDir(dir, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, filePath)
+		if err != nil || rel == "." || !entry.Type().IsRegular() {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if !include(rel) {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = name + "/" + rel
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		file, err := os.Open(filePath)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		_, err = io.Copy(tw, file)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// readArchive lê um arquivo gerado por Export. Só os arquivos conhecidos do
+// workspace são aceitos, o que impede caminhos fora do diretório de destino
+func readArchive(in io.Reader) (map[string][]byte, string, error) {
+	gz, err := gzip.NewReader(in)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid workspace archive: %w", err)
+	}
+	defer gz.Close()
+
+	allowed := append([]string{configFileName}, credentialFiles...)
+	files := map[string][]byte{}
+	name := ""
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid workspace archive: %w", err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		dir, file := path.Split(path.Clean(header.Name))
+		dir = strings.TrimSuffix(dir, "/")
+		if !slices.Contains(allowed, file) || strings.Contains(dir, "/") || dir == ".." {
+			continue
+		}
+		if header.Size > maxImportSize {
+			return nil, "", fmt.Errorf("invalid workspace archive: %s is larger than %d bytes", header.Name, maxImportSize)
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, "", err
+		}
+		files[file] = data
+		name = dir
+	}
+
+	if len(files) == 0 {
+		return nil, "", errorArchiveEmpty
+	}
+	return files, name, nil
+}
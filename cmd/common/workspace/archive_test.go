@@ -0,0 +1,222 @@
+package workspace
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path"
+	"strings"
+	"testing"
+)
+
+func newTestWorkspace(t *testing.T, files map[string]string) *workspace {
+	t.Helper()
+	w := &workspace{dirConfig: t.TempDir()}
+	if err := w.Create("source"); err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range files {
+		if err := os.WriteFile(path.Join(w.dirConfig, "source", name), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return w
+}
+
+// tarGz monta um arquivo com as entradas informadas, na ordem dada
+func tarGz(t *testing.T, entries ...tar.Header) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, header := range entries {
+		content := strings.Repeat("x", int(header.Size))
+		header.Typeflag = tar.TypeReg
+		header.Mode = 0600
+		if err := tw.WriteHeader(&header); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return &buf
+}
+
+func TestExportImportRoundTrip(t *testing.T) {
+	w := newTestWorkspace(t, map[string]string{
+		"cli.yaml":  "region: br-ne1\n",
+		"auth.yaml": "access_token: secret\n",
+	})
+
+	var archive bytes.Buffer
+	if err := w.Export("source", &archive, true); err != nil {
+		t.Fatal(err)
+	}
+	name, err := w.Import("target", &archive, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "target" {
+		t.Errorf("imported as %q, want %q", name, "target")
+	}
+
+	for file, want := range map[string]string{"cli.yaml": "region: br-ne1\n", "auth.yaml": "access_token: secret\n"} {
+		got, err := os.ReadFile(path.Join(w.dirConfig, "target", file))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != want {
+			t.Errorf("%s = %q, want %q", file, got, want)
+		}
+	}
+}
+
+func TestImportUsesArchivedName(t *testing.T) {
+	w := newTestWorkspace(t, map[string]string{"cli.yaml": "region: br-ne1\n"})
+
+	var archive bytes.Buffer
+	if err := w.Export("source", &archive, false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Import("", &archive, false); err == nil {
+		t.Fatal("expected an error importing over an existing workspace")
+	}
+
+	archive.Reset()
+	if err := w.Export("source", &archive, false); err != nil {
+		t.Fatal(err)
+	}
+	if name, err := w.Import("", &archive, true); err != nil || name != "source" {
+		t.Errorf("Import() = %q, %v, want %q", name, err, "source")
+	}
+}
+
+func TestExportWithoutCredentials(t *testing.T) {
+	w := newTestWorkspace(t, map[string]string{
+		"cli.yaml":      "region: br-ne1\n",
+		"auth.yaml":     "access_token: secret\n",
+		"auth.yaml.age": "encrypted",
+		"cache.json":    "{}",
+	})
+
+	var archive bytes.Buffer
+	if err := w.Export("source", &archive, false); err != nil {
+		t.Fatal(err)
+	}
+	files, name, err := readArchive(&archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "source" {
+		t.Errorf("archived name = %q, want %q", name, "source")
+	}
+	if len(files) != 1 || files["cli.yaml"] == nil {
+		t.Errorf("exported files = %v, want only cli.yaml", keys(files))
+	}
+}
+
+func TestReadArchiveIgnoresPathsOutsideWorkspace(t *testing.T) {
+	archive := tarGz(t,
+		tar.Header{Name: "../cli.yaml", Size: 4},
+		tar.Header{Name: "source/../../auth.yaml", Size: 4},
+		tar.Header{Name: "/etc/source/cli.yaml", Size: 4},
+		tar.Header{Name: "source/nested/cli.yaml", Size: 4},
+		tar.Header{Name: "source/other.yaml", Size: 4},
+	)
+	if _, _, err := readArchive(archive); err != errorArchiveEmpty {
+		t.Fatalf("got %v, want %v", err, errorArchiveEmpty)
+	}
+
+	archive = tarGz(t,
+		tar.Header{Name: "../auth.yaml", Size: 4},
+		tar.Header{Name: "source/cli.yaml", Size: 4},
+	)
+	files, name, err := readArchive(archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "source" || len(files) != 1 || files["cli.yaml"] == nil {
+		t.Errorf("got %q with %v, want source with cli.yaml", name, keys(files))
+	}
+}
+
+func TestReadArchiveRejectsLargeFiles(t *testing.T) {
+	archive := tarGz(t, tar.Header{Name: "source/cli.yaml", Size: maxImportSize + 1})
+	if _, _, err := readArchive(archive); err == nil {
+		t.Fatal("expected an error for a file larger than the import limit")
+	}
+}
+
+func TestArchiveBeforeDeleteAll(t *testing.T) {
+	w := newTestWorkspace(t, map[string]string{
+		"cli.yaml":   "region: br-ne1\n",
+		"auth.yaml":  "access_token: secret\n",
+		"cache.json": "{}",
+	})
+
+	archivePath, err := w.Archive("source")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.DeleteAll("source"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(path.Join(w.dirConfig, "source")); !os.IsNotExist(err) {
+		t.Fatalf("workspace still exists: %v", err)
+	}
+
+	if path.Dir(archivePath) != path.Join(w.dirConfig, archiveDirName) {
+		t.Errorf("archive created at %s, want inside %s", archivePath, archiveDirName)
+	}
+	file, err := os.Open(archivePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	// o arquivo guarda todos os arquivos do workspace, não só os importáveis
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := tar.NewReader(gz)
+	archived := map[string]bool{}
+	for {
+		header, err := tr.Next()
+		if err != nil {
+			break
+		}
+		archived[header.Name] = true
+	}
+	for _, name := range []string{"source/cli.yaml", "source/auth.yaml", "source/cache.json"} {
+		if !archived[name] {
+			t.Errorf("%s missing from the archive", name)
+		}
+	}
+}
+
+func TestDeleteAllRefusesCurrentWorkspace(t *testing.T) {
+	w := newTestWorkspace(t, map[string]string{"cli.yaml": "region: br-ne1\n"})
+	if err := w.Set("source"); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.DeleteAll("source"); err != errorDeleteCurrentNotAllowed {
+		t.Errorf("got %v, want %v", err, errorDeleteCurrentNotAllowed)
+	}
+}
+
+func keys(files map[string][]byte) []string {
+	names := []string{}
+	for name := range files {
+		names = append(names, name)
+	}
+	return names
+}
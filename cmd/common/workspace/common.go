@@ -19,12 +19,13 @@ var errorWorkspaceAlreadyExists = errors.New("workspace already exists")
 var errorDeleteCurrentNotAllowed = errors.New("cannot delete current workspace")
 var errorCopyToSelf = errors.New("cannot copy to itself")
 var errorWorkspaceNotFound = errors.New("workspace not found")
+var errorWorkspaceNotEmpty = errors.New("workspace is not empty, use --force to archive and remove it")
 
 const currentWorkspaceNameFile = "current"
 const defaultWorkspaceName = "default"
 const envWorkspaceVar = "MGC_WORKSPACE"
 const FILE_PERMISSION = 0644
-const DIR_PERMISSION = 0744
+const DIR_PERMISSION = 0700
 
 func buildMGCPath() (string, error) {
 	dir := ""
@@ -180,3 +181,36 @@ func copyDir(src string, dst string) (err error) {
 
 	return
 }
+
+// WriteFileAtomic grava em um arquivo temporário no mesmo diretório e o
+// renomeia ao final, para que uma falha no meio da escrita (ou um leitor
+// concorrente) nunca veja o arquivo pela metade
+func WriteFileAtomic(filePath string, data []byte, perm os.FileMode) error {
+	dir := filepath.Dir(filePath)
+	// o diretório do workspace padrão só existe depois da primeira escrita
+	if err := os.MkdirAll(dir, DIR_PERMISSION); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(dir, filepath.Base(filePath)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), perm); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filePath)
+}
@@ -1,6 +1,8 @@
 package workspace
 
 import (
+	"fmt"
+	"io"
 	"os"
 	"path"
 )
@@ -9,6 +11,11 @@ type Workspace interface {
 	Create(name string) error
 	Delete(name string) error
 	Copy(source string, target string) error
+	Rename(source string, target string) error
+	Export(name string, out io.Writer, includeCredentials bool) error
+	Import(name string, in io.Reader, overwrite bool) (string, error)
+	Archive(name string) (string, error)
+	DeleteAll(name string) error
 	Get() Workspace
 	List() ([]Workspace, error)
 	Set(name string) error
@@ -20,6 +27,9 @@ type Workspace interface {
 type workspace struct {
 	current   string
 	dirConfig string
+	// override é o workspace escolhido só para esta execução (--workspace ou
+	// MGC_WORKSPACE), que tem precedência sobre o arquivo current
+	override string
 }
 
 func NewWorkspace() Workspace {
@@ -34,7 +44,40 @@ func NewWorkspace() Workspace {
 	}
 }
 
+// Resolve retorna o workspace desta execução. name (flag --workspace) tem
+// precedência sobre MGC_WORKSPACE, que tem precedência sobre projectName (do
+// .mgc.yaml). Sem nenhum deles vale o workspace escolhido com "workspace
+// select". A escolha não altera o arquivo current, então execuções
+// simultâneas podem usar workspaces diferentes
+func Resolve(name string, projectName string) (Workspace, error) {
+	w := NewWorkspace().(*workspace)
+	if name == "" {
+		name = os.Getenv(envWorkspaceVar)
+	}
+	if name == "" {
+		name = projectName
+	}
+	if name == "" {
+		return w.Current(), nil
+	}
+
+	// o workspace padrão só é criado na primeira escrita
+	if name != defaultWorkspaceName {
+		if err := checkWorkspaceName(w.dirConfig, name); err != nil {
+			return w.Current(), fmt.Errorf("workspace %s: %w", name, err)
+		}
+	}
+	w.override = name
+	return w.Current(), nil
+}
+
 func (w *workspace) Copy(source string, target string) error {
+	if err := checkWorkspaceName(w.dirConfig, source); err != nil {
+		return err
+	}
+	if err := w.checkNewName(target); err != nil {
+		return err
+	}
 	err := copyDir(path.Join(w.dirConfig, source), path.Join(w.dirConfig, target))
 	return err
 }
@@ -43,7 +86,7 @@ func (w *workspace) Create(name string) error {
 	if _, err := os.Stat(path.Join(w.dirConfig, name)); err == nil {
 		return errorWorkspaceAlreadyExists
 	}
-	err := os.Mkdir(path.Join(w.dirConfig, name), 0755)
+	err := os.Mkdir(path.Join(w.dirConfig, name), DIR_PERMISSION)
 	if err != nil {
 		return err
 	}
@@ -54,6 +97,9 @@ func (w *workspace) Delete(name string) error {
 	if _, err := os.Stat(path.Join(w.dirConfig, name)); os.IsNotExist(err) {
 		return errorWorkspaceNotFound
 	}
+	if entries, err := os.ReadDir(path.Join(w.dirConfig, name)); err == nil && len(entries) > 0 {
+		return errorWorkspaceNotEmpty
+	}
 	err := os.Remove(path.Join(w.dirConfig, name))
 	if err != nil {
 		return err
@@ -77,7 +123,8 @@ func (w *workspace) List() ([]Workspace, error) {
 
 	workspaces := []Workspace{}
 	for _, file := range files {
-		if !file.IsDir() {
+		// .archive guarda os workspaces apagados com delete --force
+		if !file.IsDir() || file.Name() == archiveDirName {
 			continue
 		}
 		workspaces = append(workspaces, &workspace{current: file.Name()})
@@ -100,6 +147,11 @@ func (w *workspace) Set(name string) error {
 }
 
 func (w *workspace) Current() Workspace {
+	if w.override != "" {
+		w.current = w.override
+		return w
+	}
+
 	name := defaultWorkspaceName
 
 	data, err := read(path.Join(w.dirConfig, currentWorkspaceNameFile))
@@ -0,0 +1,117 @@
+package workspace
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// newResolveDir cria os workspaces informados em um diretório de config
+// temporário e seleciona current, quando informado
+func newResolveDir(t *testing.T, current string, names ...string) string {
+	t.Helper()
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv(envWorkspaceVar, "")
+	for _, name := range names {
+		if err := os.MkdirAll(filepath.Join(dir, "mgc", name), DIR_PERMISSION); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if current != "" {
+		if err := os.WriteFile(filepath.Join(dir, "mgc", currentWorkspaceNameFile), []byte(current), FILE_PERMISSION); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestResolvePrecedence(t *testing.T) {
+	tests := []struct {
+		name     string
+		flag     string
+		env      string
+		project  string
+		current  string
+		expected string
+	}{
+		{name: "flag over env and project", flag: "flag", env: "env", project: "project", current: "selected", expected: "flag"},
+		{name: "env over project", env: "env", project: "project", current: "selected", expected: "env"},
+		{name: "project over current", project: "project", current: "selected", expected: "project"},
+		{name: "current", current: "selected", expected: "selected"},
+		{name: "default without current", expected: defaultWorkspaceName},
+		{name: "default by name", flag: defaultWorkspaceName, current: "selected", expected: defaultWorkspaceName},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := newResolveDir(t, tt.current, "flag", "env", "project", "selected")
+			t.Setenv(envWorkspaceVar, tt.env)
+
+			ws, err := Resolve(tt.flag, tt.project)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ws.Name() != tt.expected {
+				t.Errorf("got %q, want %q", ws.Name(), tt.expected)
+			}
+			if want := filepath.Join(dir, "mgc", tt.expected); ws.Dir() != want {
+				t.Errorf("dir = %s, want %s", ws.Dir(), want)
+			}
+		})
+	}
+}
+
+func TestResolveDoesNotWriteCurrent(t *testing.T) {
+	dir := newResolveDir(t, "selected", "selected", "other")
+	currentFile := filepath.Join(dir, "mgc", currentWorkspaceNameFile)
+
+	ws, err := Resolve("other", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Current e Get são chamados pela CLI depois da resolução
+	ws.Current()
+	ws.Get()
+
+	data, err := os.ReadFile(currentFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "selected" {
+		t.Errorf("current file = %q, want %q", data, "selected")
+	}
+	if ws.Name() != "other" {
+		t.Errorf("got %q, want %q", ws.Name(), "other")
+	}
+
+	// sem current, a resolução também não cria o arquivo
+	dir = newResolveDir(t, "", "other")
+	if _, err := Resolve("other", ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "mgc", currentWorkspaceNameFile)); !os.IsNotExist(err) {
+		t.Errorf("current file created: %v", err)
+	}
+}
+
+func TestResolveUnknownWorkspace(t *testing.T) {
+	newResolveDir(t, "", "selected")
+	for _, tt := range []struct{ flag, env, project string }{
+		{flag: "missing"},
+		{env: "missing"},
+		{project: "missing"},
+		{flag: "../selected"},
+	} {
+		t.Setenv(envWorkspaceVar, tt.env)
+		ws, err := Resolve(tt.flag, tt.project)
+		if err == nil {
+			t.Errorf("%+v: expected an error, got workspace %q", tt, ws.Name())
+			continue
+		}
+		if tt.flag == "missing" && !errors.Is(err, errorWorkspaceNotFound) {
+			t.Errorf("got %v, want %v", err, errorWorkspaceNotFound)
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package cmd
+
+import (
+	"context"
+
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
+	availabilityzonesSdk "github.com/MagaluCloud/mgc-sdk-go/availabilityzones"
+	blockstorageSdk "github.com/MagaluCloud/mgc-sdk-go/blockstorage"
+	sdk "github.com/MagaluCloud/mgc-sdk-go/client"
+	computeSdk "github.com/MagaluCloud/mgc-sdk-go/compute"
+	containerregistrySdk "github.com/MagaluCloud/mgc-sdk-go/containerregistry"
+	dbaasSdk "github.com/MagaluCloud/mgc-sdk-go/dbaas"
+	kubernetesSdk "github.com/MagaluCloud/mgc-sdk-go/kubernetes"
+	lbaasSdk "github.com/MagaluCloud/mgc-sdk-go/lbaas"
+	networkSdk "github.com/MagaluCloud/mgc-sdk-go/network"
+	sshkeysSdk "github.com/MagaluCloud/mgc-sdk-go/sshkeys"
+	"github.com/spf13/cobra"
+)
+
+// addCompletions registra a completion dinâmica dos ids de recursos e dos
+// valores de flags que vêm da API (tipos de máquina, imagens, zonas...).
+// O "id" de cada grupo completa o próprio recurso; flags como cluster-id
+// completam em todo o produto. Flags de id também aceitam o nome do recurso
+func addCompletions(root *cobra.Command, sdkCoreConfig sdk.CoreClient) {
+	endpoint, ok := cmdutils.EndpointFromContext(root.Context())
+	if !ok || endpointErr != nil {
+		return
+	}
+
+	addComputeCompletions(root, sdkCoreConfig, endpoint)
+	addBlockStorageCompletions(root, sdkCoreConfig, endpoint)
+	addKubernetesCompletions(root, sdkCoreConfig)
+	addNetworkCompletions(root, sdkCoreConfig)
+	addDbaasCompletions(root, sdkCoreConfig, endpoint)
+	addContainerRegistryCompletions(root, sdkCoreConfig)
+	addLbaasCompletions(root, sdkCoreConfig)
+	addProfileCompletions(root, sdkCoreConfig, endpoint)
+}
+
+func addComputeCompletions(root *cobra.Command, sdkCoreConfig sdk.CoreClient, endpoint cmdutils.Endpoint) {
+	computeService := computeSdk.New(&sdkCoreConfig)
+	product := cmdutils.FindCommand(root, "virtual-machine")
+
+	instances := cmdutils.CompletionSource{Resource: "compute.instances", Field: "id", Fetch: func(ctx context.Context) (any, error) {
+		return computeService.Instances().ListAll(ctx, computeSdk.InstanceFilterOptions{})
+	}}
+	completeID(cmdutils.FindCommand(root, "virtual-machine", "instances"), "id", instances)
+	completeID(product, "instance.id", instances)
+	completeID(cmdutils.FindCommand(root, "block-storage"), "instance-id", instances)
+
+	completeID(cmdutils.FindCommand(root, "virtual-machine", "snapshots"), "id", cmdutils.CompletionSource{Resource: "compute.snapshots", Field: "id", Fetch: func(ctx context.Context) (any, error) {
+		return computeService.Snapshots().ListAll(ctx, computeSdk.SnapshotFilterOptions{})
+	}})
+
+	machineTypes := cmdutils.CompletionSource{Resource: "compute.instance-types", Field: "name", Fetch: func(ctx context.Context) (any, error) {
+		return computeService.InstanceTypes().ListAll(ctx, computeSdk.InstanceTypeFilterOptions{})
+	}}
+	cmdutils.CompleteFlag(product, "machine-type.name", machineTypes)
+	cmdutils.CompleteFlag(product, "new-type.name", machineTypes)
+
+	cmdutils.CompleteFlag(product, "image.name", cmdutils.CompletionSource{Resource: "compute.images", Field: "name", Fetch: func(ctx context.Context) (any, error) {
+		return computeService.Images().ListAll(ctx, computeSdk.ImageFilterOptions{})
+	}})
+
+	cmdutils.CompleteFlag(product, "availability-zone", availabilityZones(sdkCoreConfig, endpoint))
+}
+
+func addBlockStorageCompletions(root *cobra.Command, sdkCoreConfig sdk.CoreClient, endpoint cmdutils.Endpoint) {
+	blockstorageService := blockstorageSdk.New(&sdkCoreConfig)
+	product := cmdutils.FindCommand(root, "block-storage")
+
+	volumes := cmdutils.CompletionSource{Resource: "blockstorage.volumes", Field: "id", Fetch: func(ctx context.Context) (any, error) {
+		return blockstorageService.Volumes().ListAll(ctx, blockstorageSdk.VolumeFilterOptions{})
+	}}
+	completeID(cmdutils.FindCommand(root, "block-storage", "volumes"), "id", volumes)
+	completeID(product, "volume-id", volumes)
+
+	completeID(cmdutils.FindCommand(root, "block-storage", "snapshots"), "id", cmdutils.CompletionSource{Resource: "blockstorage.snapshots", Field: "id", Fetch: func(ctx context.Context) (any, error) {
+		return blockstorageService.Snapshots().ListAll(ctx, blockstorageSdk.SnapshotFilterOptions{})
+	}})
+
+	volumeTypes := cmdutils.CompletionSource{Resource: "blockstorage.volume-types", Field: "name", Fetch: func(ctx context.Context) (any, error) {
+		return blockstorageService.VolumeTypes().ListAll(ctx, blockstorageSdk.VolumeTypeFilterOptions{})
+	}}
+	cmdutils.CompleteFlag(product, "type.name", volumeTypes)
+	cmdutils.CompleteFlag(product, "new-type.name", volumeTypes)
+
+	cmdutils.CompleteFlag(product, "availability-zone", availabilityZones(sdkCoreConfig, endpoint))
+}
+
+func addKubernetesCompletions(root *cobra.Command, sdkCoreConfig sdk.CoreClient) {
+	kubernetesService := kubernetesSdk.New(&sdkCoreConfig)
+	product := cmdutils.FindCommand(root, "kubernetes")
+
+	clusters := cmdutils.CompletionSource{Resource: "kubernetes.clusters", Field: "id", Fetch: func(ctx context.Context) (any, error) {
+		opts := kubernetesSdk.ListOptions{}
+		return cmdutils.FetchAll(ctx, &opts.Offset, &opts.Limit, func(ctx context.Context) (any, error) {
+			return kubernetesService.Clusters().List(ctx, opts)
+		})
+	}}
+	completeID(cmdutils.FindCommand(root, "kubernetes", "clusters"), "id", clusters)
+	completeID(product, "cluster-id", clusters)
+
+	cmdutils.CompleteFlag(product, "version", cmdutils.CompletionSource{Resource: "kubernetes.versions", Field: "version", Fetch: func(ctx context.Context) (any, error) {
+		return kubernetesService.Versions().List(ctx)
+	}})
+
+	cmdutils.CompleteFlag(product, "flavor", cmdutils.CompletionSource{Resource: "kubernetes.flavors", Field: "name", Fetch: func(ctx context.Context) (any, error) {
+		flavors, err := kubernetesService.Flavors().List(ctx, kubernetesSdk.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return flavors.NodePool, nil
+	}})
+}
+
+func addNetworkCompletions(root *cobra.Command, sdkCoreConfig sdk.CoreClient) {
+	networkService := networkSdk.New(&sdkCoreConfig)
+	product := cmdutils.FindCommand(root, "network")
+
+	vpcs := cmdutils.CompletionSource{Resource: "network.vpcs", Field: "id", Fetch: func(ctx context.Context) (any, error) {
+		return networkService.VPCs().List(ctx)
+	}}
+	completeID(cmdutils.FindCommand(root, "network", "vpcs"), "id", vpcs)
+	completeID(product, "vpc-id", vpcs)
+	completeID(product, "vpcid", vpcs)
+
+	ports := cmdutils.CompletionSource{Resource: "network.ports", Field: "id", Fetch: func(ctx context.Context) (any, error) {
+		return networkService.Ports().List(ctx)
+	}}
+	completeID(cmdutils.FindCommand(root, "network", "ports"), "id", ports)
+	completeID(product, "port-id", ports)
+
+	securityGroups := cmdutils.CompletionSource{Resource: "network.security-groups", Field: "id", Fetch: func(ctx context.Context) (any, error) {
+		return networkService.SecurityGroups().List(ctx)
+	}}
+	completeID(cmdutils.FindCommand(root, "network", "security-groups"), "id", securityGroups)
+	completeID(product, "security-group-id", securityGroups)
+
+	publicIPs := cmdutils.CompletionSource{Resource: "network.public-ips", Field: "id", Fetch: func(ctx context.Context) (any, error) {
+		return networkService.PublicIPs().List(ctx)
+	}}
+	completeID(cmdutils.FindCommand(root, "network", "public-ips"), "id", publicIPs)
+	completeID(product, "public-ipid", publicIPs)
+	completeID(cmdutils.FindCommand(root, "lbaas"), "public-ipid", publicIPs)
+}
+
+func addDbaasCompletions(root *cobra.Command, sdkCoreConfig sdk.CoreClient, endpoint cmdutils.Endpoint) {
+	dbaasService := dbaasSdk.New(&sdkCoreConfig)
+	product := cmdutils.FindCommand(root, "dbaas")
+
+	instances := cmdutils.CompletionSource{Resource: "dbaas.instances", Field: "id", Fetch: func(ctx context.Context) (any, error) {
+		return dbaasService.Instances().ListAll(ctx, dbaasSdk.InstanceFilterOptions{})
+	}}
+	completeID(cmdutils.FindCommand(root, "dbaas", "instances"), "id", instances)
+	completeID(product, "instance-id", instances)
+
+	completeID(cmdutils.FindCommand(root, "dbaas", "clusters"), "id", cmdutils.CompletionSource{Resource: "dbaas.clusters", Field: "id", Fetch: func(ctx context.Context) (any, error) {
+		return dbaasService.Clusters().ListAll(ctx, dbaasSdk.ClusterFilterOptions{})
+	}})
+
+	completeID(product, "engine-id", cmdutils.CompletionSource{Resource: "dbaas.engines", Field: "id", Fetch: func(ctx context.Context) (any, error) {
+		return dbaasService.Engines().ListAll(ctx, dbaasSdk.EngineFilterOptions{})
+	}})
+
+	completeID(product, "instance-type-id", cmdutils.CompletionSource{Resource: "dbaas.instance-types", Field: "id", Fetch: func(ctx context.Context) (any, error) {
+		return dbaasService.InstanceTypes().ListAll(ctx, dbaasSdk.InstanceTypeFilterOptions{})
+	}})
+
+	completeID(product, "parameter-group-id", cmdutils.CompletionSource{Resource: "dbaas.parameter-groups", Field: "id", Fetch: func(ctx context.Context) (any, error) {
+		return dbaasService.ParametersGroup().ListAll(ctx, dbaasSdk.ParameterGroupFilterOptions{})
+	}})
+
+	cmdutils.CompleteFlag(product, "availability-zone", availabilityZones(sdkCoreConfig, endpoint))
+}
+
+func addContainerRegistryCompletions(root *cobra.Command, sdkCoreConfig sdk.CoreClient) {
+	containerregistryService := containerregistrySdk.New(&sdkCoreConfig)
+
+	completeID(cmdutils.FindCommand(root, "container-registry"), "registry-id", cmdutils.CompletionSource{Resource: "containerregistry.registries", Field: "id", Fetch: func(ctx context.Context) (any, error) {
+		return containerregistryService.Registries().ListAll(ctx, containerregistrySdk.RegistryFilterOptions{})
+	}})
+}
+
+func addLbaasCompletions(root *cobra.Command, sdkCoreConfig sdk.CoreClient) {
+	lbaasService := lbaasSdk.New(&sdkCoreConfig)
+
+	loadBalancers := cmdutils.CompletionSource{Resource: "lbaas.network-load-balancers", Field: "id", Fetch: func(ctx context.Context) (any, error) {
+		return lbaasService.NetworkLoadBalancers().ListAll(ctx)
+	}}
+	completeID(cmdutils.FindCommand(root, "lbaas", "network-load-balancers"), "id", loadBalancers)
+	completeID(cmdutils.FindCommand(root, "lbaas"), "lb-id", loadBalancers)
+}
+
+func addProfileCompletions(root *cobra.Command, sdkCoreConfig sdk.CoreClient, endpoint cmdutils.Endpoint) {
+	sshkeysService := sshkeysSdk.New(&sdkCoreConfig, sshkeysSdk.WithGlobalBasePath(endpoint.GlobalURL()))
+
+	completeID(cmdutils.FindCommand(root, "profile"), "key-id", cmdutils.CompletionSource{Resource: "profile.ssh-keys", Field: "id", Fetch: func(ctx context.Context) (any, error) {
+		opts := sshkeysSdk.ListOptions{}
+		return cmdutils.FetchAll(ctx, &opts.Offset, &opts.Limit, func(ctx context.Context) (any, error) {
+			return sshkeysService.Keys().List(ctx, opts)
+		})
+	}})
+}
+
+// completeID completa a flag de id e permite informar o nome do recurso no lugar do id
+func completeID(parent *cobra.Command, flag string, source cmdutils.CompletionSource) {
+	cmdutils.CompleteFlag(parent, flag, source)
+	cmdutils.ResolveFlag(parent, flag, source)
+}
+
+// availabilityZones lista as zonas da região atual (ex: br-se1-a)
+func availabilityZones(sdkCoreConfig sdk.CoreClient, endpoint cmdutils.Endpoint) cmdutils.CompletionSource {
+	service := availabilityzonesSdk.New(&sdkCoreConfig, availabilityzonesSdk.WithGlobalBasePath(endpoint.GlobalURL())).AvailabilityZones()
+	return cmdutils.CompletionSource{Resource: "profile.availability-zones", Field: "az_id", Fetch: func(ctx context.Context) (any, error) {
+		regions, err := service.List(ctx, availabilityzonesSdk.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		zones := []availabilityzonesSdk.AvailabilityZone{}
+		for _, region := range regions {
+			if region.ID == endpoint.Region {
+				zones = append(zones, region.AvailabilityZones...)
+			}
+		}
+		return zones, nil
+	}}
+}
@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/magaluCloud/mgccli/cmd/common/config"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+)
+
+// configFlags relaciona as flags globais às configs que elas sobrescrevem
+var configFlags = []struct {
+	flag   string
+	config string
+	isBool bool
+}{
+	{flag: regionFlag, config: cmdutils.CFG_REGION},
+	{flag: "raw", config: cmdutils.CFG_RAW_OUTPUT, isBool: true},
+	{flag: noConfirmationFlag, config: cmdutils.CFG_NO_CONFIRM, isBool: true},
+}
+
+// applyConfigOverrides aplica sobre as configs do workspace e do projeto as
+// variáveis MGC_<NOME> e, por cima delas, as flags globais informadas
+func applyConfigOverrides(cfg config.Config, args cmdutils.ArgsParser) error {
+	var errs []error
+	if err := config.ApplyEnv(cfg); err != nil {
+		errs = append(errs, err)
+	}
+
+	for _, item := range configFlags {
+		var value string
+		var present bool
+		if item.isBool {
+			var err error
+			if value, present, err = boolFlagValue(args.AllArgs(), item.flag); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+		} else {
+			value, present, _ = args.GetValue(item.flag)
+		}
+		if !present || value == "" {
+			continue
+		}
+		if err := cfg.Override(item.config, value, config.SourceFlag, "--"+item.flag); err != nil {
+			errs = append(errs, fmt.Errorf("invalid value %q for --%s: %w", value, item.flag, err))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return cmdutils.NewUsageError(err.Error(), "list the accepted values with: config list")
+	}
+	return nil
+}
+
+// boolFlagValue lê uma flag bool como o pflag: sem =valor ela vale true e o
+// argumento seguinte não é consumido. Vale a última ocorrência antes de --
+func boolFlagValue(args []string, flag string) (string, bool, error) {
+	value, present := "", false
+	for _, arg := range args {
+		if arg == "--" {
+			break
+		}
+		if arg == "--"+flag {
+			value, present = "true", true
+			continue
+		}
+		explicit, ok := strings.CutPrefix(arg, "--"+flag+"=")
+		if !ok {
+			continue
+		}
+		parsed, err := strconv.ParseBool(explicit)
+		if err != nil {
+			return "", true, fmt.Errorf("invalid value %q for --%s: expected true or false", explicit, flag)
+		}
+		value, present = strconv.FormatBool(parsed), true
+	}
+	return value, present, nil
+}
@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/magaluCloud/mgccli/cmd/common/config"
+	"github.com/magaluCloud/mgccli/cmd/common/workspace"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+)
+
+func TestBoolFlagValue(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          []string
+		expectedValue string
+		present       bool
+		invalid       bool
+	}{
+		{name: "absent", args: []string{"compute", "instances", "list"}},
+		{name: "without value", args: []string{"--raw"}, expectedValue: "true", present: true},
+		{name: "does not consume the next argument", args: []string{"--raw", "false"}, expectedValue: "true", present: true},
+		{name: "before a positional argument", args: []string{"--raw", "instances", "list"}, expectedValue: "true", present: true},
+		{name: "explicit false", args: []string{"--raw=false"}, expectedValue: "false", present: true},
+		{name: "explicit short form", args: []string{"--raw=0"}, expectedValue: "false", present: true},
+		{name: "last occurrence wins", args: []string{"--raw=false", "--raw"}, expectedValue: "true", present: true},
+		{name: "after terminator", args: []string{"--", "--raw"}},
+		{name: "other flag with same prefix", args: []string{"--raw-output"}},
+		{name: "invalid value", args: []string{"--raw=ture"}, present: true, invalid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, present, err := boolFlagValue(tt.args, "raw")
+			if (err != nil) != tt.invalid {
+				t.Fatalf("unexpected error state: %v", err)
+			}
+			if value != tt.expectedValue || present != tt.present {
+				t.Errorf("got %q (present %v), want %q (present %v)", value, present, tt.expectedValue, tt.present)
+			}
+		})
+	}
+}
+
+// fakeArgs lê as flags de texto nas formas --flag=valor e --flag valor
+type fakeArgs []string
+
+func (a fakeArgs) FullProgramPath() string { return "mgc" }
+func (a fakeArgs) AllArgs() []string       { return a }
+func (a fakeArgs) GetValue(key string) (string, bool, error) {
+	for i, arg := range a {
+		if value, ok := strings.CutPrefix(arg, "--"+key+"="); ok {
+			return value, true, nil
+		}
+		if arg == "--"+key && i+1 < len(a) {
+			return a[i+1], true, nil
+		}
+	}
+	return "", false, errors.New("not found")
+}
+func (a fakeArgs) GetValueWithDefault(key string, defaultValue string) (string, bool, error) {
+	if value, present, err := a.GetValue(key); err == nil {
+		return value, present, nil
+	}
+	return defaultValue, false, nil
+}
+
+func TestApplyConfigOverrides(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv(config.EnvName(cmdutils.CFG_NO_CONFIRM), "true")
+	cfg := config.NewConfig(workspace.NewWorkspace().Current(), nil)
+
+	if err := applyConfigOverrides(cfg, fakeArgs{"--no-confirm=false", "--raw"}); err != nil {
+		t.Fatal(err)
+	}
+	for name, expected := range map[string]bool{cmdutils.CFG_NO_CONFIRM: false, cmdutils.CFG_RAW_OUTPUT: true} {
+		item, err := cfg.Get(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if item.Value != expected || item.Source != config.SourceFlag {
+			t.Errorf("%s = %v (%s), want %v (flag)", name, item.Value, item.Source, expected)
+		}
+	}
+}
+
+func TestApplyConfigOverridesRejectsInvalidBool(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	cfg := config.NewConfig(workspace.NewWorkspace().Current(), nil)
+
+	err := applyConfigOverrides(cfg, fakeArgs{"--raw=ture"})
+	var cliErr *cmdutils.CliError
+	if !errors.As(err, &cliErr) || cliErr.Kind != cmdutils.ErrorKindUsage {
+		t.Fatalf("got %v, want a usage error", err)
+	}
+	if item, _ := cfg.Get(cmdutils.CFG_RAW_OUTPUT); item.Source == config.SourceFlag {
+		t.Error("invalid --raw value was applied")
+	}
+}
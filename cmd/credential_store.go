@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/magaluCloud/mgccli/cmd/common/auth"
+	"github.com/magaluCloud/mgccli/cmd/common/config"
+	"github.com/magaluCloud/mgccli/cmd/common/workspace"
+
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+	"github.com/spf13/cobra"
+)
+
+// newCredentialStore escolhe onde ficam as credenciais do workspace conforme
+// a config credential_store. Com prompt falso (ex: completions do shell), a
+// senha só é lida da variável de ambiente
+func newCredentialStore(ws workspace.Workspace, cfg config.Config, prompt bool) auth.CredentialStore {
+	if value, err := cfg.Value(cmdutils.CFG_CREDENTIAL_STORE); err == nil && value.String() == auth.StoreEncrypted {
+		return auth.NewEncryptedStore(ws.Dir(), func(confirm bool) (string, error) {
+			return credentialsPassphrase(confirm, prompt)
+		})
+	}
+	return auth.NewFileStore(ws.Dir())
+}
+
+// credentialsPassphrase usa a variável de ambiente, para uso em scripts, ou
+// pergunta a senha ao usuário
+func credentialsPassphrase(confirm bool, prompt bool) (string, error) {
+	if passphrase := os.Getenv(cmdutils.ENV_CREDENTIALS_PASSPHRASE.String()); passphrase != "" {
+		return passphrase, nil
+	}
+	if !prompt || !cmdutils.IsInteractive() {
+		return "", cmdutils.NewUsageError(
+			"credentials are encrypted but the session is not interactive",
+			fmt.Sprintf("set %s with the passphrase", cmdutils.ENV_CREDENTIALS_PASSPHRASE),
+		)
+	}
+	if confirm {
+		return cmdutils.PromptSecret("Confirm the passphrase")
+	}
+	return cmdutils.PromptSecret("Passphrase for the workspace credentials")
+}
+
+// isCompletionRequest informa se a CLI foi chamada pelo script de completion
+// do shell, que não pode exibir prompts
+func isCompletionRequest(args cmdutils.ArgsParser) bool {
+	all := args.AllArgs()
+	return len(all) > 0 && (all[0] == cobra.ShellCompRequestCmd || all[0] == cobra.ShellCompNoDescRequestCmd)
+}
@@ -0,0 +1,16 @@
+package cmd
+
+import (
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+	"github.com/spf13/cobra"
+)
+
+const dryRunFlag = cmdutils.DryRunFlag
+
+func addDryRunFlag(cmd *cobra.Command) {
+	cmd.Root().PersistentFlags().Bool(
+		dryRunFlag,
+		false,
+		"Print the operation, parameters and request body of commands that change resources, without calling the API",
+	)
+}
@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	logFileFlag   = "log-file"
+	logFormatFlag = "log-format"
+	traceHTTPFlag = "trace-http"
+
+	logFormatText = "text"
+	logFormatJSON = "json"
+)
+
+func addLogFlags(cmd *cobra.Command) {
+	cmd.Root().PersistentFlags().String(
+		logFileFlag,
+		"",
+		"Write logs to this file instead of stderr",
+	)
+	cmd.Root().PersistentFlags().String(
+		logFormatFlag,
+		logFormatText,
+		"Log format: text or json",
+	)
+	cmd.RegisterFlagCompletionFunc(logFormatFlag, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{logFormatText, logFormatJSON}, cobra.ShellCompDirectiveNoFileComp
+	})
+	cmd.Root().PersistentFlags().Bool(
+		traceHTTPFlag,
+		false,
+		"Log every HTTP request and response (headers and bodies, with credentials redacted)",
+	)
+}
+
+// newLogger monta o logger a partir das flags lidas antes do cobra. Os logs
+// nunca vão para stdout, para não se misturarem com a saída dos comandos
+func newLogger(args cmdutils.ArgsParser) (logger *slog.Logger, trace bool) {
+	level := slog.LevelError
+	if value, present, _ := args.GetValue(debugLevelFlag); present {
+		level = slog.Level(parseDebugLevel(value))
+	}
+
+	if value, present, _ := args.GetValue(traceHTTPFlag); present {
+		enabled, err := strconv.ParseBool(value)
+		trace = err != nil || enabled
+	}
+	if trace && level > slog.LevelDebug {
+		level = slog.LevelDebug
+	}
+
+	var w io.Writer = os.Stderr
+	if path, present, _ := args.GetValue(logFileFlag); present && path != "" {
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: cannot open log file %s, logging to stderr: %v\n", path, err)
+		} else {
+			w = file
+		}
+	}
+
+	options := &slog.HandlerOptions{Level: level}
+	format, _, _ := args.GetValueWithDefault(logFormatFlag, logFormatText)
+	if strings.ToLower(format) == logFormatJSON {
+		return slog.New(slog.NewJSONHandler(w, options)), trace
+	}
+	return slog.New(slog.NewTextHandler(w, options)), trace
+}
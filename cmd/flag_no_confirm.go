@@ -1,8 +1,11 @@
 package cmd
 
-import "github.com/spf13/cobra"
+import (
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+	"github.com/spf13/cobra"
+)
 
-const noConfirmationFlag = "no-confirm"
+const noConfirmationFlag = cmdutils.NoConfirmFlag
 
 func addNoConfirmationFlag(cmd *cobra.Command) {
 	cmd.Root().PersistentFlags().Bool(
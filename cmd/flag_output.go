@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/magaluCloud/mgccli/beautiful"
+	"github.com/magaluCloud/mgccli/cmd/common/config"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	outputFlag  = "output"
+	columnsFlag = "columns"
+	queryFlag   = "query"
+)
+
+func addOutputFlag(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringP(
+		outputFlag,
+		"o",
+		"",
+		fmt.Sprintf("Output format (%s). Defaults to the default_output config", strings.Join(beautiful.FormatNames(), "|")),
+	)
+	cmd.RegisterFlagCompletionFunc(outputFlag, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return beautiful.FormatNames(), cobra.ShellCompDirectiveNoFileComp
+	})
+}
+
+func addColumnsFlag(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringSlice(
+		columnsFlag,
+		nil,
+		"Columns shown by table/csv/tsv outputs, dotted paths select nested fields (e.g. id,name,machine_type.name)",
+	)
+}
+
+func getColumnsFlag(cmd *cobra.Command) []string {
+	columns, err := cmd.Root().PersistentFlags().GetStringSlice(columnsFlag)
+	if err != nil {
+		return nil
+	}
+	return columns
+}
+
+func addQueryFlag(cmd *cobra.Command) {
+	cmd.PersistentFlags().String(
+		queryFlag,
+		"",
+		"JMESPath expression applied to the output before formatting (e.g. \"instances[?status=='running'].id\")",
+	)
+}
+
+func getQueryFlag(cmd *cobra.Command) string {
+	query, err := cmd.Root().PersistentFlags().GetString(queryFlag)
+	if err != nil {
+		return ""
+	}
+	return query
+}
+
+func getOutputFlag(cmd *cobra.Command) string {
+	output, err := cmd.Root().PersistentFlags().GetString(outputFlag)
+	if err != nil {
+		return ""
+	}
+	return output
+}
+
+// explicitJSONOutput informa se o usuário escolheu uma saída JSON, pela flag
+// --output ou pela config default_output. O json usado por padrão não conta,
+// para que erros em sessões interativas continuem legíveis
+func explicitJSONOutput(cmd *cobra.Command) bool {
+	if format := getOutputFlag(cmd); format != "" {
+		return beautiful.IsJSONFormat(format)
+	}
+	if cfg, ok := cmd.Context().Value(cmdutils.CXT_CONFIG_KEY).(config.Config); ok {
+		item, err := cfg.Get(cmdutils.CFG_DEFAULT_OUTPUT)
+		if err == nil && item.Source != config.SourceDefault {
+			return beautiful.IsJSONFormat(config.NewValue(item.Value).String())
+		}
+	}
+	return false
+}
+
+// setupOutput prepara a saída do comando: query, saída crua, colunas e formato.
+// O formato segue flag --output > config default_output > json, e quando
+// --columns é usado sem --output a saída passa a ser em tabela
+func setupOutput(cmd *cobra.Command) error {
+	if err := beautiful.SetQuery(getQueryFlag(cmd)); err != nil {
+		return cmdutils.NewUsageError(err.Error(), "")
+	}
+
+	// a config raw_output (ou MGC_RAW_OUTPUT) equivale a --raw
+	if cfg, ok := cmd.Context().Value(cmdutils.CXT_CONFIG_KEY).(config.Config); ok && !getRawOutputFlag(cmd) {
+		if value, err := cfg.Value(cmdutils.CFG_RAW_OUTPUT); err == nil && value.Bool() {
+			cmd.Root().PersistentFlags().Set("raw", "true")
+		}
+	}
+
+	columns := getColumnsFlag(cmd)
+	beautiful.SetColumns(columns)
+
+	format := getOutputFlag(cmd)
+	if format == "" && len(columns) > 0 {
+		format = beautiful.FormatTable
+	}
+	if cfg, ok := cmd.Context().Value(cmdutils.CXT_CONFIG_KEY).(config.Config); ok && format == "" {
+		value, err := cfg.Value(cmdutils.CFG_DEFAULT_OUTPUT)
+		if err == nil {
+			format = value.String()
+		}
+	}
+	if format == "" {
+		format = beautiful.FormatJSON
+	}
+
+	if err := beautiful.SetDefaultFormat(format); err != nil {
+		return cmdutils.NewUsageError(err.Error(), "")
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package cmd
+
+import (
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+	"github.com/spf13/cobra"
+)
+
+const regionFlag = cmdutils.RegionFlag
+
+func addRegionFlag(cmd *cobra.Command) {
+	cmd.Root().PersistentFlags().String(
+		regionFlag,
+		"",
+		"Region to reach the service (br-se1, br-ne1, br-mgl1). Overrides MGC_REGION and the region config",
+	)
+	cmd.RegisterFlagCompletionFunc(regionFlag, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return cmdutils.Regions(), cobra.ShellCompDirectiveNoFileComp
+	})
+}
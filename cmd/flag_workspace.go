@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"github.com/magaluCloud/mgccli/cmd/common/config"
+	"github.com/magaluCloud/mgccli/cmd/common/workspace"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+	"github.com/spf13/cobra"
+)
+
+const workspaceFlag = "workspace"
+
+func addWorkspaceFlag(cmd *cobra.Command) {
+	cmd.Root().PersistentFlags().String(
+		workspaceFlag,
+		"",
+		"Workspace used only by this command, without changing the selected one. Overrides MGC_WORKSPACE",
+	)
+	cmd.RegisterFlagCompletionFunc(workspaceFlag, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		workspaces, err := workspace.NewWorkspace().List()
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		names := make([]string, 0, len(workspaces))
+		for _, ws := range workspaces {
+			names = append(names, ws.Name())
+		}
+		return names, cobra.ShellCompDirectiveNoFileComp
+	})
+}
+
+// resolveWorkspace lê --workspace direto dos argumentos, já que o workspace
+// precisa ser conhecido antes de carregar config e credenciais
+func resolveWorkspace(args cmdutils.ArgsParser, project *config.Project) (workspace.Workspace, error) {
+	name, _, _ := args.GetValue(workspaceFlag)
+	projectName := ""
+	if project != nil {
+		projectName = project.Workspace
+	}
+	ws, err := workspace.Resolve(name, projectName)
+	if err != nil {
+		return ws, cmdutils.NewUsageError(err.Error(), "list the available workspaces with: workspace list")
+	}
+	return ws, nil
+}
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/magaluCloud/mgccli/cmd/common/config"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+)
+
+func TestResolveWorkspace(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	for _, name := range []string{"flag", "env", "project", "selected"} {
+		if err := os.MkdirAll(filepath.Join(dir, "mgc", name), 0700); err != nil {
+			t.Fatal(err)
+		}
+	}
+	currentFile := filepath.Join(dir, "mgc", "current")
+	if err := os.WriteFile(currentFile, []byte("selected"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	project := &config.Project{Workspace: "project"}
+
+	tests := []struct {
+		name     string
+		args     fakeArgs
+		env      string
+		project  *config.Project
+		expected string
+	}{
+		{name: "flag", args: fakeArgs{"--workspace", "flag"}, env: "env", project: project, expected: "flag"},
+		{name: "flag with value", args: fakeArgs{"--workspace=flag"}, env: "env", project: project, expected: "flag"},
+		{name: "env", env: "env", project: project, expected: "env"},
+		{name: "project", project: project, expected: "project"},
+		{name: "selected", expected: "selected"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("MGC_WORKSPACE", tt.env)
+			ws, err := resolveWorkspace(tt.args, tt.project)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ws.Name() != tt.expected {
+				t.Errorf("got %q, want %q", ws.Name(), tt.expected)
+			}
+			if data, _ := os.ReadFile(currentFile); string(data) != "selected" {
+				t.Errorf("current file changed to %q", data)
+			}
+		})
+	}
+}
+
+func TestResolveWorkspaceUnknown(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("MGC_WORKSPACE", "")
+
+	_, err := resolveWorkspace(fakeArgs{"--workspace", "missing"}, nil)
+	var cliErr *cmdutils.CliError
+	if !errors.As(err, &cliErr) || cliErr.Kind != cmdutils.ErrorKindUsage {
+		t.Fatalf("got %v, want a usage error", err)
+	}
+}
@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	auditSdk "github.com/MagaluCloud/mgc-sdk-go/audit"
@@ -92,11 +94,16 @@ func List(ctx context.Context, parent *cobra.Command, eventService auditSdk.Even
 				params.TypeLike = params_TypeLikeFlag.Value
 			} // CobraFlagsAssign
 
-			indexexpr, err := eventService.List(ctx, &params)
+			indexexpr, streamed, err := cmdutils.ListAll(ctx, cmd, &params.Offset, &params.Limit, func(ctx context.Context) (any, error) {
+				return eventService.List(ctx, &params)
+			})
 
 			if err != nil {
 				return err
 			}
+			if streamed {
+				return nil
+			}
 
 			raw, _ := cmd.Root().PersistentFlags().GetBool("raw")
 			beautiful.NewOutput(raw).PrintData(indexexpr)
@@ -124,6 +131,9 @@ func List(ctx context.Context, parent *cobra.Command, eventService auditSdk.Even
 
 	params_TypeLikeFlag = flags.NewStr(cmd, "type-like", "", "") //CobraFlagsCreation
 
+	cmdutils.AddPaginationFlags(cmd) //CobraFlagsCreation
+	cmdutils.AddAllRegionsFlag(cmd)  //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
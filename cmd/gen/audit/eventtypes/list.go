@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	auditSdk "github.com/MagaluCloud/mgc-sdk-go/audit"
@@ -50,11 +52,16 @@ func List(ctx context.Context, parent *cobra.Command, eventTypeService auditSdk.
 				params.TenantID = params_TenantIDFlag.Value
 			} // CobraFlagsAssign
 
-			indexexpr, err := eventTypeService.List(ctx, &params)
+			indexexpr, streamed, err := cmdutils.ListAll(ctx, cmd, &params.Offset, &params.Limit, func(ctx context.Context) (any, error) {
+				return eventTypeService.List(ctx, &params)
+			})
 
 			if err != nil {
 				return err
 			}
+			if streamed {
+				return nil
+			}
 
 			raw, _ := cmd.Root().PersistentFlags().GetBool("raw")
 			beautiful.NewOutput(raw).PrintData(indexexpr)
@@ -68,6 +75,9 @@ func List(ctx context.Context, parent *cobra.Command, eventTypeService auditSdk.
 
 	params_TenantIDFlag = flags.NewStr(cmd, "tenant-id", "", "") //CobraFlagsCreation
 
+	cmdutils.AddPaginationFlags(cmd) //CobraFlagsCreation
+	cmdutils.AddAllRegionsFlag(cmd)  //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -13,7 +13,7 @@ package schedulers
 import (
 	"context"
 
-	"fmt"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
 
 	"github.com/spf13/cobra"
 
@@ -34,6 +34,10 @@ func AttachVolume(ctx context.Context, parent *cobra.Command, schedulerService b
 
 			req := blockstorageSdk.SchedulerVolumeIdentifierPayload{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var id string // ServiceSDKParamCreate
 
 			if len(args) > 0 {
@@ -42,9 +46,13 @@ func AttachVolume(ctx context.Context, parent *cobra.Command, schedulerService b
 			if idFlag.IsChanged() {
 				id = *idFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o id como argumento ou usar a flag --id")
+				return cmdutils.NewMissingArgError("id")
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "SchedulerService.AttachVolume", map[string]any{"id": id}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			err := schedulerService.AttachVolume(ctx, id, req)
 
 			if err != nil {
@@ -57,6 +65,8 @@ func AttachVolume(ctx context.Context, parent *cobra.Command, schedulerService b
 
 	idFlag = flags.NewStr(cmd, "id", "", " (required)") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	blockstorageSdk "github.com/MagaluCloud/mgc-sdk-go/blockstorage"
@@ -42,6 +44,10 @@ func Create(ctx context.Context, parent *cobra.Command, schedulerService blockst
 
 			req := blockstorageSdk.SchedulerPayload{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			if req_DescriptionFlag.IsChanged() {
 				req.Description = req_DescriptionFlag.Value
 			} // CobraFlagsAssign
@@ -62,6 +68,10 @@ func Create(ctx context.Context, parent *cobra.Command, schedulerService blockst
 				req.Snapshot.Type = *req_Snapshot_TypeFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "SchedulerService.Create", nil, map[string]any{"req": req}) {
+				return nil
+			}
+
 			result, err := schedulerService.Create(ctx, req)
 
 			if err != nil {
@@ -84,6 +94,8 @@ func Create(ctx context.Context, parent *cobra.Command, schedulerService blockst
 
 	req_Snapshot_TypeFlag = flags.NewStr(cmd, "snapshot.type", "", "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
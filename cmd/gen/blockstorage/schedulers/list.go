@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	blockstorageSdk "github.com/MagaluCloud/mgc-sdk-go/blockstorage"
@@ -59,11 +61,16 @@ func List(ctx context.Context, parent *cobra.Command, schedulerService blockstor
 				opts.Sort = opts_SortFlag.Value
 			} // CobraFlagsAssign
 
-			schedulerlistresponse, err := schedulerService.List(ctx, opts)
+			schedulerlistresponse, streamed, err := cmdutils.ListAll(ctx, cmd, &opts.Offset, &opts.Limit, func(ctx context.Context) (any, error) {
+				return schedulerService.List(ctx, opts)
+			})
 
 			if err != nil {
 				return err
 			}
+			if streamed {
+				return nil
+			}
 
 			raw, _ := cmd.Root().PersistentFlags().GetBool("raw")
 			beautiful.NewOutput(raw).PrintData(schedulerlistresponse)
@@ -79,6 +86,9 @@ func List(ctx context.Context, parent *cobra.Command, schedulerService blockstor
 
 	opts_SortFlag = flags.NewStr(cmd, "sort", "", "") //CobraFlagsCreation
 
+	cmdutils.AddPaginationFlags(cmd) //CobraFlagsCreation
+	cmdutils.AddAllRegionsFlag(cmd)  //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	blockstorageSdk "github.com/MagaluCloud/mgc-sdk-go/blockstorage"
@@ -38,9 +40,17 @@ func Create(ctx context.Context, parent *cobra.Command, snapshotService blocksto
 
 			req := blockstorageSdk.CreateSnapshotRequest{} // ServiceSDKParamCreate
 
-			req.SourceSnapshot = &blockstorageSdk.IDOrName{} //CobraStructInitialize
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
+			if req.SourceSnapshot == nil {
+				req.SourceSnapshot = &blockstorageSdk.IDOrName{}
+			} //CobraStructInitialize
 
-			req.Volume = &blockstorageSdk.IDOrName{} //CobraStructInitialize
+			if req.Volume == nil {
+				req.Volume = &blockstorageSdk.IDOrName{}
+			} //CobraStructInitialize
 
 			if req_DescriptionFlag.IsChanged() {
 				req.Description = req_DescriptionFlag.Value
@@ -54,6 +64,10 @@ func Create(ctx context.Context, parent *cobra.Command, snapshotService blocksto
 				req.Type = req_TypeFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "SnapshotService.Create", nil, map[string]any{"req": req}) {
+				return nil
+			}
+
 			result, err := snapshotService.Create(ctx, req)
 
 			if err != nil {
@@ -72,6 +86,8 @@ func Create(ctx context.Context, parent *cobra.Command, snapshotService blocksto
 
 	req_TypeFlag = flags.NewStr(cmd, "type", "", "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
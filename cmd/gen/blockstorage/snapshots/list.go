@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	blockstorageSdk "github.com/MagaluCloud/mgc-sdk-go/blockstorage"
@@ -59,11 +61,16 @@ func List(ctx context.Context, parent *cobra.Command, snapshotService blockstora
 				opts.Sort = opts_SortFlag.Value
 			} // CobraFlagsAssign
 
-			listsnapshotsresponse, err := snapshotService.List(ctx, opts)
+			listsnapshotsresponse, streamed, err := cmdutils.ListAll(ctx, cmd, &opts.Offset, &opts.Limit, func(ctx context.Context) (any, error) {
+				return snapshotService.List(ctx, opts)
+			})
 
 			if err != nil {
 				return err
 			}
+			if streamed {
+				return nil
+			}
 
 			raw, _ := cmd.Root().PersistentFlags().GetBool("raw")
 			beautiful.NewOutput(raw).PrintData(listsnapshotsresponse)
@@ -79,6 +86,9 @@ func List(ctx context.Context, parent *cobra.Command, snapshotService blockstora
 
 	opts_SortFlag = flags.NewStr(cmd, "sort", "", "") //CobraFlagsCreation
 
+	cmdutils.AddPaginationFlags(cmd) //CobraFlagsCreation
+	cmdutils.AddAllRegionsFlag(cmd)  //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -13,10 +13,10 @@ package volumes
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/spf13/cobra"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	blockstorageSdk "github.com/MagaluCloud/mgc-sdk-go/blockstorage"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -44,7 +44,7 @@ func Attach(ctx context.Context, parent *cobra.Command, volumeService blockstora
 			if volumeIDFlag.IsChanged() {
 				volumeID = *volumeIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o volume-id como argumento ou usar a flag --volume-id")
+				return cmdutils.NewMissingArgError("volume-id")
 			} // CobraFlagsAssign
 
 			if len(args) > 1 {
@@ -53,15 +53,23 @@ func Attach(ctx context.Context, parent *cobra.Command, volumeService blockstora
 			if instanceIDFlag.IsChanged() {
 				instanceID = *instanceIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o instance-id como argumento ou usar a flag --instance-id")
+				return cmdutils.NewMissingArgError("instance-id")
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "VolumeService.Attach", map[string]any{"volumeID": volumeID, "instanceID": instanceID}, nil) {
+				return nil
+			}
+
 			err := volumeService.Attach(ctx, volumeID, instanceID)
 
 			if err != nil {
 				return err
 			}
 
+			if err := waitVolume(ctx, cmd, volumeService, volumeID, "in-use"); err != nil {
+				return err
+			}
+
 			return nil
 		},
 	}
@@ -70,6 +78,8 @@ func Attach(ctx context.Context, parent *cobra.Command, volumeService blockstora
 
 	volumeIDFlag = flags.NewStr(cmd, "volume-id", "", " (required)") //CobraFlagsCreation
 
+	cmdutils.AddWaitFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	blockstorageSdk "github.com/MagaluCloud/mgc-sdk-go/blockstorage"
@@ -44,7 +46,13 @@ func Create(ctx context.Context, parent *cobra.Command, volumeService blockstora
 
 			req := blockstorageSdk.CreateVolumeRequest{} // ServiceSDKParamCreate
 
-			req.Snapshot = &blockstorageSdk.IDOrName{} //CobraStructInitialize
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
+			if req.Snapshot == nil {
+				req.Snapshot = &blockstorageSdk.IDOrName{}
+			} //CobraStructInitialize
 
 			if req_AvailabilityZoneFlag.IsChanged() {
 				req.AvailabilityZone = req_AvailabilityZoneFlag.Value
@@ -70,6 +78,10 @@ func Create(ctx context.Context, parent *cobra.Command, volumeService blockstora
 				req.Type.Name = req_Type_NameFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "VolumeService.Create", nil, map[string]any{"req": req}) {
+				return nil
+			}
+
 			result, err := volumeService.Create(ctx, req)
 
 			if err != nil {
@@ -94,6 +106,8 @@ func Create(ctx context.Context, parent *cobra.Command, volumeService blockstora
 
 	req_Type_NameFlag = flags.NewStr(cmd, "type.name", "", "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -13,7 +13,7 @@ package volumes
 import (
 	"context"
 
-	"fmt"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
 
 	"github.com/spf13/cobra"
 
@@ -40,9 +40,13 @@ func Detach(ctx context.Context, parent *cobra.Command, volumeService blockstora
 			if volumeIDFlag.IsChanged() {
 				volumeID = *volumeIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o volume-id como argumento ou usar a flag --volume-id")
+				return cmdutils.NewMissingArgError("volume-id")
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "VolumeService.Detach", map[string]any{"volumeID": volumeID}, nil) {
+				return nil
+			}
+
 			err := volumeService.Detach(ctx, volumeID)
 
 			if err != nil {
@@ -13,10 +13,10 @@ package volumes
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/spf13/cobra"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	blockstorageSdk "github.com/MagaluCloud/mgc-sdk-go/blockstorage"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -36,6 +36,10 @@ func Extend(ctx context.Context, parent *cobra.Command, volumeService blockstora
 
 			req := blockstorageSdk.ExtendVolumeRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var id string // ServiceSDKParamCreate
 
 			if len(args) > 0 {
@@ -44,19 +48,27 @@ func Extend(ctx context.Context, parent *cobra.Command, volumeService blockstora
 			if idFlag.IsChanged() {
 				id = *idFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o id como argumento ou usar a flag --id")
+				return cmdutils.NewMissingArgError("id")
 			} // CobraFlagsAssign
 
 			if req_SizeFlag.IsChanged() {
 				req.Size = *req_SizeFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "VolumeService.Extend", map[string]any{"id": id}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			err := volumeService.Extend(ctx, id, req)
 
 			if err != nil {
 				return err
 			}
 
+			if err := waitVolumeResize(ctx, cmd, volumeService, id, req.Size, "available", "in-use"); err != nil {
+				return err
+			}
+
 			return nil
 		},
 	}
@@ -65,6 +77,10 @@ func Extend(ctx context.Context, parent *cobra.Command, volumeService blockstora
 
 	req_SizeFlag = flags.NewInt(cmd, "size", 0, "") //CobraFlagsCreation
 
+	cmdutils.AddWaitFlags(cmd) //CobraFlagsCreation
+
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
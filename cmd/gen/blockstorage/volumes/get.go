@@ -13,12 +13,12 @@ package volumes
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
 	"github.com/spf13/cobra"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	blockstorageSdk "github.com/MagaluCloud/mgc-sdk-go/blockstorage"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -44,7 +44,7 @@ func Get(ctx context.Context, parent *cobra.Command, volumeService blockstorageS
 			if idFlag.IsChanged() {
 				id = *idFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o id como argumento ou usar a flag --id")
+				return cmdutils.NewMissingArgError("id")
 			} // CobraFlagsAssign
 
 			volume, err := volumeService.Get(ctx, id, expand)
@@ -13,7 +13,7 @@ package volumes
 import (
 	"context"
 
-	"fmt"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
 
 	"github.com/spf13/cobra"
 
@@ -44,7 +44,7 @@ func Rename(ctx context.Context, parent *cobra.Command, volumeService blockstora
 			if idFlag.IsChanged() {
 				id = *idFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o id como argumento ou usar a flag --id")
+				return cmdutils.NewMissingArgError("id")
 			} // CobraFlagsAssign
 
 			if len(args) > 1 {
@@ -53,9 +53,13 @@ func Rename(ctx context.Context, parent *cobra.Command, volumeService blockstora
 			if newNameFlag.IsChanged() {
 				newName = *newNameFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o new-name como argumento ou usar a flag --new-name")
+				return cmdutils.NewMissingArgError("new-name")
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "VolumeService.Rename", map[string]any{"id": id, "newName": newName}, nil) {
+				return nil
+			}
+
 			err := volumeService.Rename(ctx, id, newName)
 
 			if err != nil {
@@ -13,7 +13,7 @@ package volumes
 import (
 	"context"
 
-	"fmt"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
 
 	"github.com/spf13/cobra"
 
@@ -38,6 +38,10 @@ func Retype(ctx context.Context, parent *cobra.Command, volumeService blockstora
 
 			req := blockstorageSdk.RetypeVolumeRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var id string // ServiceSDKParamCreate
 
 			if len(args) > 0 {
@@ -46,7 +50,7 @@ func Retype(ctx context.Context, parent *cobra.Command, volumeService blockstora
 			if idFlag.IsChanged() {
 				id = *idFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o id como argumento ou usar a flag --id")
+				return cmdutils.NewMissingArgError("id")
 			} // CobraFlagsAssign
 
 			if req_NewType_IDFlag.IsChanged() {
@@ -57,6 +61,10 @@ func Retype(ctx context.Context, parent *cobra.Command, volumeService blockstora
 				req.NewType.Name = req_NewType_NameFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "VolumeService.Retype", map[string]any{"id": id}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			err := volumeService.Retype(ctx, id, req)
 
 			if err != nil {
@@ -73,6 +81,8 @@ func Retype(ctx context.Context, parent *cobra.Command, volumeService blockstora
 
 	req_NewType_NameFlag = flags.NewStr(cmd, "new-type.name", "", "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -39,5 +39,7 @@ func VolumesCmd(ctx context.Context, parent *cobra.Command, volumeService blocks
 
 	Retype(ctx, cmd, volumeService)
 
+	Wait(ctx, cmd, volumeService)
+
 	parent.AddCommand(cmd)
 }
@@ -0,0 +1,57 @@
+package volumes
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	blockstorageSdk "github.com/MagaluCloud/mgc-sdk-go/blockstorage"
+
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+)
+
+// volumeStatus retorna o status e o state atuais do volume
+func volumeStatus(volumeService blockstorageSdk.VolumeService) func(ctx context.Context, id string) ([]string, error) {
+	return func(ctx context.Context, id string) ([]string, error) {
+		volume, err := volumeService.Get(ctx, id, nil)
+		if err != nil {
+			return nil, err
+		}
+		return []string{volume.Status, volume.State}, nil
+	}
+}
+
+// waitVolume aguarda o volume atingir um dos status quando --wait é usado
+func waitVolume(ctx context.Context, cmd *cobra.Command, volumeService blockstorageSdk.VolumeService, id string, target ...string) error {
+	if !cmdutils.ShouldWait(cmd) {
+		return nil
+	}
+	opts := cmdutils.NewWaitOptions(cmd, "volume "+id, target...)
+	return cmdutils.WaitForStatus(ctx, opts, func(ctx context.Context) ([]string, error) {
+		return volumeStatus(volumeService)(ctx, id)
+	})
+}
+
+// waitVolumeResize aguarda o fim do extend: o volume já está disponível antes
+// da operação, então só é considerado pronto depois de passar por um status
+// transitório ou de exibir o novo tamanho
+func waitVolumeResize(ctx context.Context, cmd *cobra.Command, volumeService blockstorageSdk.VolumeService, id string, size int, target ...string) error {
+	if !cmdutils.ShouldWait(cmd) {
+		return nil
+	}
+	opts := cmdutils.NewWaitOptions(cmd, "volume "+id, target...)
+	opts.Applied = func(ctx context.Context) (bool, error) {
+		volume, err := volumeService.Get(ctx, id, nil)
+		if err != nil {
+			return false, err
+		}
+		return volume.Size >= size, nil
+	}
+	return cmdutils.WaitForStatus(ctx, opts, func(ctx context.Context) ([]string, error) {
+		return volumeStatus(volumeService)(ctx, id)
+	})
+}
+
+func Wait(ctx context.Context, parent *cobra.Command, volumeService blockstorageSdk.VolumeService) {
+	parent.AddCommand(cmdutils.NewWaitCmd(ctx, "volume", "available", volumeStatus(volumeService)))
+}
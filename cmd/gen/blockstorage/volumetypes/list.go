@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	blockstorageSdk "github.com/MagaluCloud/mgc-sdk-go/blockstorage"
@@ -68,11 +70,16 @@ func List(ctx context.Context, parent *cobra.Command, volumeTypeService blocksto
 				opts.Sort = opts_SortFlag.Value
 			} // CobraFlagsAssign
 
-			listvolumetypesresponse, err := volumeTypeService.List(ctx, opts)
+			listvolumetypesresponse, streamed, err := cmdutils.ListAll(ctx, cmd, &opts.Offset, &opts.Limit, func(ctx context.Context) (any, error) {
+				return volumeTypeService.List(ctx, opts)
+			})
 
 			if err != nil {
 				return err
 			}
+			if streamed {
+				return nil
+			}
 
 			raw, _ := cmd.Root().PersistentFlags().GetBool("raw")
 			beautiful.NewOutput(raw).PrintData(listvolumetypesresponse)
@@ -92,6 +99,9 @@ func List(ctx context.Context, parent *cobra.Command, volumeTypeService blocksto
 
 	opts_SortFlag = flags.NewStr(cmd, "sort", "", "") //CobraFlagsCreation
 
+	cmdutils.AddPaginationFlags(cmd) //CobraFlagsCreation
+	cmdutils.AddAllRegionsFlag(cmd)  //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -13,6 +13,8 @@ package instances
 import (
 	"context"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	computeSdk "github.com/MagaluCloud/mgc-sdk-go/compute"
@@ -38,6 +40,10 @@ func AttachNetworkInterface(ctx context.Context, parent *cobra.Command, instance
 
 			req := computeSdk.NICRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			if req_Instance_IDFlag.IsChanged() {
 				req.Instance.ID = req_Instance_IDFlag.Value
 			} // CobraFlagsAssign
@@ -54,6 +60,10 @@ func AttachNetworkInterface(ctx context.Context, parent *cobra.Command, instance
 				req.Network.Interface.Name = req_Network_Interface_NameFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "InstanceService.AttachNetworkInterface", nil, map[string]any{"req": req}) {
+				return nil
+			}
+
 			err := instanceService.AttachNetworkInterface(ctx, req)
 
 			if err != nil {
@@ -72,6 +82,8 @@ func AttachNetworkInterface(ctx context.Context, parent *cobra.Command, instance
 
 	req_Network_Interface_NameFlag = flags.NewStr(cmd, "network.interface.name", "", "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -17,6 +17,8 @@ import (
 
 	"github.com/spf13/cobra"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	computeSdk "github.com/MagaluCloud/mgc-sdk-go/compute"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -50,7 +52,13 @@ func Create(ctx context.Context, parent *cobra.Command, instanceService computeS
 
 			req := computeSdk.CreateRequest{} // ServiceSDKParamCreate
 
-			req.Network = &computeSdk.CreateParametersNetwork{} //CobraStructInitialize
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
+			if req.Network == nil {
+				req.Network = &computeSdk.CreateParametersNetwork{}
+			} //CobraStructInitialize
 
 			if req_AvailabilityZoneFlag.IsChanged() {
 				req.AvailabilityZone = req_AvailabilityZoneFlag.Value
@@ -88,12 +96,20 @@ func Create(ctx context.Context, parent *cobra.Command, instanceService computeS
 				req.UserData = req_UserDataFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "InstanceService.Create", nil, map[string]any{"req": req}) {
+				return nil
+			}
+
 			result, err := instanceService.Create(ctx, req)
 
 			if err != nil {
 				return err
 			}
 
+			if err := waitInstance(ctx, cmd, instanceService, result, "running"); err != nil {
+				return err
+			}
+
 			raw, _ := cmd.Root().PersistentFlags().GetBool("raw")
 			beautiful.NewOutput(raw).PrintData(result)
 			return nil
@@ -118,6 +134,10 @@ func Create(ctx context.Context, parent *cobra.Command, instanceService computeS
 
 	req_UserDataFlag = flags.NewStr(cmd, "user-data", "", "") //CobraFlagsCreation
 
+	cmdutils.AddWaitFlags(cmd) //CobraFlagsCreation
+
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -0,0 +1,87 @@
+package instances
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+// TestCreateFromFileWithFlags garante que os objetos aninhados lidos de
+// --from-file são mantidos quando flags completam ou sobrescrevem o corpo
+func TestCreateFromFileWithFlags(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "request.yaml")
+	content := `name: from-file
+machine_type:
+  id: type-id
+image:
+  name: ubuntu
+network:
+  associate_public_ip: true
+  vpc:
+    id: vpc-id
+`
+	if err := os.WriteFile(file, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	root := &cobra.Command{Use: "mgc"}
+	root.PersistentFlags().Bool("dry-run", false, "")
+	root.PersistentFlags().Bool("raw", false, "")
+	Create(context.Background(), root, nil)
+	root.SetArgs([]string{"create", "--from-file", file, "--name", "from-flag", "--machine-type.name", "type-name", "--dry-run", "--raw"})
+
+	output := captureStdout(t, func() {
+		if err := root.Execute(); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	var result struct {
+		Body map[string]any `json:"body"`
+	}
+	if err := json.Unmarshal(output, &result); err != nil {
+		t.Fatalf("invalid output %q: %s", output, err)
+	}
+
+	expected := map[string]any{
+		"name":         "from-flag",
+		"machine_type": map[string]any{"id": "type-id", "name": "type-name"},
+		"image":        map[string]any{"name": "ubuntu"},
+		"network": map[string]any{
+			"associate_public_ip": true,
+			"vpc":                 map[string]any{"id": "vpc-id"},
+		},
+	}
+	for key, value := range expected {
+		got, _ := json.Marshal(result.Body[key])
+		want, _ := json.Marshal(value)
+		if string(got) != string(want) {
+			t.Errorf("%s = %s, want %s", key, got, want)
+		}
+	}
+}
+
+func captureStdout(t *testing.T, run func()) []byte {
+	t.Helper()
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = writer
+	defer func() { os.Stdout = stdout }()
+
+	done := make(chan []byte)
+	go func() {
+		data, _ := io.ReadAll(reader)
+		done <- data
+	}()
+	run()
+	writer.Close()
+	return <-done
+}
@@ -13,9 +13,7 @@ package instances
 import (
 	"context"
 
-	"fmt"
-
-	"github.com/charmbracelet/huh"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
 
 	"github.com/spf13/cobra"
 
@@ -46,7 +44,7 @@ func Delete(ctx context.Context, parent *cobra.Command, instanceService computeS
 			if idFlag.IsChanged() {
 				id = *idFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o id como argumento ou usar a flag --id")
+				return cmdutils.NewMissingArgError("id")
 			} // CobraFlagsAssign
 
 			if len(args) > 1 {
@@ -55,12 +53,18 @@ func Delete(ctx context.Context, parent *cobra.Command, instanceService computeS
 			if deletePublicIPFlag.IsChanged() {
 				deletePublicIP = *deletePublicIPFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o delete-public-ip como argumento ou usar a flag --delete-public-ip")
+				return cmdutils.NewMissingArgError("delete-public-ip")
 			} // CobraFlagsAssign
 
-			var confirm bool
-			huh.NewConfirm().Title("This action cannot be undone. Proceed?").Affirmative("Yes").Negative("No").Value(&confirm).Run()
-			if !confirm {
+			if cmdutils.DryRun(cmd, "InstanceService.Delete", map[string]any{"id": id, "deletePublicIP": deletePublicIP}, nil) {
+				return nil
+			}
+
+			confirmed, confirmErr := cmdutils.Confirm(cmd, "This action cannot be undone. Proceed?")
+			if confirmErr != nil {
+				return confirmErr
+			}
+			if !confirmed {
 				return nil
 			}
 
@@ -13,12 +13,12 @@ package instances
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
 	"github.com/spf13/cobra"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	computeSdk "github.com/MagaluCloud/mgc-sdk-go/compute"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -53,7 +53,7 @@ func Get(ctx context.Context, parent *cobra.Command, instanceService computeSdk.
 			if idFlag.IsChanged() {
 				id = *idFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o id como argumento ou usar a flag --id")
+				return cmdutils.NewMissingArgError("id")
 			} // CobraFlagsAssign
 
 			instance, err := instanceService.Get(ctx, id, expand)
@@ -13,12 +13,12 @@ package instances
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
 	"github.com/spf13/cobra"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	computeSdk "github.com/MagaluCloud/mgc-sdk-go/compute"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -42,7 +42,7 @@ func GetFirstWindowsPassword(ctx context.Context, parent *cobra.Command, instanc
 			if idFlag.IsChanged() {
 				id = *idFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o id como argumento ou usar a flag --id")
+				return cmdutils.NewMissingArgError("id")
 			} // CobraFlagsAssign
 
 			windowspasswordresponse, err := instanceService.GetFirstWindowsPassword(ctx, id)
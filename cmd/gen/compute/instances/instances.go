@@ -47,5 +47,7 @@ func InstancesCmd(ctx context.Context, parent *cobra.Command, instanceService co
 
 	Suspend(ctx, cmd, instanceService)
 
+	Wait(ctx, cmd, instanceService)
+
 	parent.AddCommand(cmd)
 }
@@ -13,7 +13,7 @@ package instances
 import (
 	"context"
 
-	"fmt"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
 
 	"github.com/spf13/cobra"
 
@@ -38,6 +38,10 @@ func Retype(ctx context.Context, parent *cobra.Command, instanceService computeS
 
 			req := computeSdk.RetypeRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var id string // ServiceSDKParamCreate
 
 			if len(args) > 0 {
@@ -46,7 +50,7 @@ func Retype(ctx context.Context, parent *cobra.Command, instanceService computeS
 			if idFlag.IsChanged() {
 				id = *idFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o id como argumento ou usar a flag --id")
+				return cmdutils.NewMissingArgError("id")
 			} // CobraFlagsAssign
 
 			if req_MachineType_IDFlag.IsChanged() {
@@ -57,6 +61,10 @@ func Retype(ctx context.Context, parent *cobra.Command, instanceService computeS
 				req.MachineType.Name = req_MachineType_NameFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "InstanceService.Retype", map[string]any{"id": id}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			err := instanceService.Retype(ctx, id, req)
 
 			if err != nil {
@@ -73,6 +81,8 @@ func Retype(ctx context.Context, parent *cobra.Command, instanceService computeS
 
 	req_MachineType_NameFlag = flags.NewStr(cmd, "machine-type.name", "", "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
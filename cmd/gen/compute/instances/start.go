@@ -13,10 +13,10 @@ package instances
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/spf13/cobra"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	computeSdk "github.com/MagaluCloud/mgc-sdk-go/compute"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -40,21 +40,31 @@ func Start(ctx context.Context, parent *cobra.Command, instanceService computeSd
 			if idFlag.IsChanged() {
 				id = *idFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o id como argumento ou usar a flag --id")
+				return cmdutils.NewMissingArgError("id")
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "InstanceService.Start", map[string]any{"id": id}, nil) {
+				return nil
+			}
+
 			err := instanceService.Start(ctx, id)
 
 			if err != nil {
 				return err
 			}
 
+			if err := waitInstance(ctx, cmd, instanceService, id, "running"); err != nil {
+				return err
+			}
+
 			return nil
 		},
 	}
 
 	idFlag = flags.NewStr(cmd, "id", "", " (required)") //CobraFlagsCreation
 
+	cmdutils.AddWaitFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
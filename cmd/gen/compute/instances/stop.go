@@ -13,10 +13,10 @@ package instances
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/spf13/cobra"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	computeSdk "github.com/MagaluCloud/mgc-sdk-go/compute"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -40,21 +40,31 @@ func Stop(ctx context.Context, parent *cobra.Command, instanceService computeSdk
 			if idFlag.IsChanged() {
 				id = *idFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o id como argumento ou usar a flag --id")
+				return cmdutils.NewMissingArgError("id")
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "InstanceService.Stop", map[string]any{"id": id}, nil) {
+				return nil
+			}
+
 			err := instanceService.Stop(ctx, id)
 
 			if err != nil {
 				return err
 			}
 
+			if err := waitInstance(ctx, cmd, instanceService, id, "stopped"); err != nil {
+				return err
+			}
+
 			return nil
 		},
 	}
 
 	idFlag = flags.NewStr(cmd, "id", "", " (required)") //CobraFlagsCreation
 
+	cmdutils.AddWaitFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
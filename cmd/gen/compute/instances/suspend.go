@@ -13,7 +13,7 @@ package instances
 import (
 	"context"
 
-	"fmt"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
 
 	"github.com/spf13/cobra"
 
@@ -40,9 +40,13 @@ func Suspend(ctx context.Context, parent *cobra.Command, instanceService compute
 			if idFlag.IsChanged() {
 				id = *idFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o id como argumento ou usar a flag --id")
+				return cmdutils.NewMissingArgError("id")
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "InstanceService.Suspend", map[string]any{"id": id}, nil) {
+				return nil
+			}
+
 			err := instanceService.Suspend(ctx, id)
 
 			if err != nil {
@@ -0,0 +1,37 @@
+package instances
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	computeSdk "github.com/MagaluCloud/mgc-sdk-go/compute"
+
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+)
+
+// instanceStatus retorna o status e o state atuais da instância
+func instanceStatus(instanceService computeSdk.InstanceService) func(ctx context.Context, id string) ([]string, error) {
+	return func(ctx context.Context, id string) ([]string, error) {
+		instance, err := instanceService.Get(ctx, id, nil)
+		if err != nil {
+			return nil, err
+		}
+		return []string{instance.Status, instance.State}, nil
+	}
+}
+
+// waitInstance aguarda a instância atingir um dos status quando --wait é usado
+func waitInstance(ctx context.Context, cmd *cobra.Command, instanceService computeSdk.InstanceService, id string, target ...string) error {
+	if !cmdutils.ShouldWait(cmd) {
+		return nil
+	}
+	opts := cmdutils.NewWaitOptions(cmd, "instance "+id, target...)
+	return cmdutils.WaitForStatus(ctx, opts, func(ctx context.Context) ([]string, error) {
+		return instanceStatus(instanceService)(ctx, id)
+	})
+}
+
+func Wait(ctx context.Context, parent *cobra.Command, instanceService computeSdk.InstanceService) {
+	parent.AddCommand(cmdutils.NewWaitCmd(ctx, "instance", "running", instanceStatus(instanceService)))
+}
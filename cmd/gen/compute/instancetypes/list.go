@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	computeSdk "github.com/MagaluCloud/mgc-sdk-go/compute"
@@ -56,11 +58,16 @@ func List(ctx context.Context, parent *cobra.Command, instanceTypeService comput
 				opts.Sort = opts_SortFlag.Value
 			} // CobraFlagsAssign
 
-			instancetypelist, err := instanceTypeService.List(ctx, opts)
+			instancetypelist, streamed, err := cmdutils.ListAll(ctx, cmd, &opts.Offset, &opts.Limit, func(ctx context.Context) (any, error) {
+				return instanceTypeService.List(ctx, opts)
+			})
 
 			if err != nil {
 				return err
 			}
+			if streamed {
+				return nil
+			}
 
 			raw, _ := cmd.Root().PersistentFlags().GetBool("raw")
 			beautiful.NewOutput(raw).PrintData(instancetypelist)
@@ -76,6 +83,9 @@ func List(ctx context.Context, parent *cobra.Command, instanceTypeService comput
 
 	opts_SortFlag = flags.NewStr(cmd, "sort", "", "") //CobraFlagsCreation
 
+	cmdutils.AddPaginationFlags(cmd) //CobraFlagsCreation
+	cmdutils.AddAllRegionsFlag(cmd)  //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
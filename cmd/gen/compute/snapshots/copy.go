@@ -13,7 +13,7 @@ package snapshots
 import (
 	"context"
 
-	"fmt"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
 
 	"github.com/spf13/cobra"
 
@@ -36,6 +36,10 @@ func Copy(ctx context.Context, parent *cobra.Command, snapshotService computeSdk
 
 			req := computeSdk.CopySnapshotRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var id string // ServiceSDKParamCreate
 
 			if len(args) > 0 {
@@ -44,13 +48,17 @@ func Copy(ctx context.Context, parent *cobra.Command, snapshotService computeSdk
 			if idFlag.IsChanged() {
 				id = *idFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o id como argumento ou usar a flag --id")
+				return cmdutils.NewMissingArgError("id")
 			} // CobraFlagsAssign
 
 			if req_DestinationRegionFlag.IsChanged() {
 				req.DestinationRegion = *req_DestinationRegionFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "SnapshotService.Copy", map[string]any{"id": id}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			err := snapshotService.Copy(ctx, id, req)
 
 			if err != nil {
@@ -65,6 +73,8 @@ func Copy(ctx context.Context, parent *cobra.Command, snapshotService computeSdk
 
 	req_DestinationRegionFlag = flags.NewStr(cmd, "destination-region", "", "DestinationRegion is the region where the snapshot should be copied") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
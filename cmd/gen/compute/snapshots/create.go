@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	computeSdk "github.com/MagaluCloud/mgc-sdk-go/compute"
@@ -34,10 +36,18 @@ func Create(ctx context.Context, parent *cobra.Command, snapshotService computeS
 
 			req := computeSdk.CreateSnapshotRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			if req_NameFlag.IsChanged() {
 				req.Name = *req_NameFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "SnapshotService.Create", nil, map[string]any{"req": req}) {
+				return nil
+			}
+
 			result, err := snapshotService.Create(ctx, req)
 
 			if err != nil {
@@ -52,6 +62,8 @@ func Create(ctx context.Context, parent *cobra.Command, snapshotService computeS
 
 	req_NameFlag = flags.NewStr(cmd, "name", "", "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
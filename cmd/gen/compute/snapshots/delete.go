@@ -13,9 +13,7 @@ package snapshots
 import (
 	"context"
 
-	"fmt"
-
-	"github.com/charmbracelet/huh"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
 
 	"github.com/spf13/cobra"
 
@@ -42,12 +40,18 @@ func Delete(ctx context.Context, parent *cobra.Command, snapshotService computeS
 			if idFlag.IsChanged() {
 				id = *idFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o id como argumento ou usar a flag --id")
+				return cmdutils.NewMissingArgError("id")
 			} // CobraFlagsAssign
 
-			var confirm bool
-			huh.NewConfirm().Title("This action cannot be undone. Proceed?").Affirmative("Yes").Negative("No").Value(&confirm).Run()
-			if !confirm {
+			if cmdutils.DryRun(cmd, "SnapshotService.Delete", map[string]any{"id": id}, nil) {
+				return nil
+			}
+
+			confirmed, confirmErr := cmdutils.Confirm(cmd, "This action cannot be undone. Proceed?")
+			if confirmErr != nil {
+				return confirmErr
+			}
+			if !confirmed {
 				return nil
 			}
 
@@ -13,10 +13,10 @@ package snapshots
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	computeSdk "github.com/MagaluCloud/mgc-sdk-go/compute"
@@ -44,9 +44,15 @@ func Restore(ctx context.Context, parent *cobra.Command, snapshotService compute
 
 			req := computeSdk.RestoreSnapshotRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var id string // ServiceSDKParamCreate
 
-			req.Network = &computeSdk.CreateParametersNetwork{} //CobraStructInitialize
+			if req.Network == nil {
+				req.Network = &computeSdk.CreateParametersNetwork{}
+			} //CobraStructInitialize
 
 			if len(args) > 0 {
 				cmd.Flags().Set("id", args[0])
@@ -54,7 +60,7 @@ func Restore(ctx context.Context, parent *cobra.Command, snapshotService compute
 			if idFlag.IsChanged() {
 				id = *idFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o id como argumento ou usar a flag --id")
+				return cmdutils.NewMissingArgError("id")
 			} // CobraFlagsAssign
 
 			if req_AvailabilityZoneFlag.IsChanged() {
@@ -73,6 +79,10 @@ func Restore(ctx context.Context, parent *cobra.Command, snapshotService compute
 				req.UserData = req_UserDataFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "SnapshotService.Restore", map[string]any{"id": id}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			result, err := snapshotService.Restore(ctx, id, req)
 
 			if err != nil {
@@ -95,6 +105,8 @@ func Restore(ctx context.Context, parent *cobra.Command, snapshotService compute
 
 	req_UserDataFlag = flags.NewStr(cmd, "user-data", "", "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -13,10 +13,10 @@ package credentials
 import (
 	"context"
 
-	"github.com/charmbracelet/huh"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	containerregistrySdk "github.com/MagaluCloud/mgc-sdk-go/containerregistry"
@@ -30,9 +30,15 @@ func ResetPassword(ctx context.Context, parent *cobra.Command, credentialsServic
 		Long:  `Reset the container registry access credentials`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 
-			var confirm bool
-			huh.NewConfirm().Title("Are you sure you want to reset the container registry's password?").Affirmative("Yes").Negative("No").Value(&confirm).Run()
-			if !confirm {
+			if cmdutils.DryRun(cmd, "CredentialsService.ResetPassword", nil, nil) {
+				return nil
+			}
+
+			confirmed, confirmErr := cmdutils.Confirm(cmd, "Are you sure you want to reset the container registry's password?")
+			if confirmErr != nil {
+				return confirmErr
+			}
+			if !confirmed {
 				return nil
 			}
 
@@ -13,9 +13,7 @@ package images
 import (
 	"context"
 
-	"fmt"
-
-	"github.com/charmbracelet/huh"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
 
 	"github.com/spf13/cobra"
 
@@ -50,7 +48,7 @@ func Delete(ctx context.Context, parent *cobra.Command, imagesService containerr
 			if registryIDFlag.IsChanged() {
 				registryID = *registryIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o registry-id como argumento ou usar a flag --registry-id")
+				return cmdutils.NewMissingArgError("registry-id")
 			} // CobraFlagsAssign
 
 			if len(args) > 1 {
@@ -59,7 +57,7 @@ func Delete(ctx context.Context, parent *cobra.Command, imagesService containerr
 			if repositoryNameFlag.IsChanged() {
 				repositoryName = *repositoryNameFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o repository-name como argumento ou usar a flag --repository-name")
+				return cmdutils.NewMissingArgError("repository-name")
 			} // CobraFlagsAssign
 
 			if len(args) > 2 {
@@ -68,12 +66,18 @@ func Delete(ctx context.Context, parent *cobra.Command, imagesService containerr
 			if digestOrTagFlag.IsChanged() {
 				digestOrTag = *digestOrTagFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o digest-or-tag como argumento ou usar a flag --digest-or-tag")
+				return cmdutils.NewMissingArgError("digest-or-tag")
 			} // CobraFlagsAssign
 
-			var confirm bool
-			huh.NewConfirm().Title("This action cannot be undone. Proceed?").Affirmative("Yes").Negative("No").Value(&confirm).Run()
-			if !confirm {
+			if cmdutils.DryRun(cmd, "ImagesService.Delete", map[string]any{"registryID": registryID, "repositoryName": repositoryName, "digestOrTag": digestOrTag}, nil) {
+				return nil
+			}
+
+			confirmed, confirmErr := cmdutils.Confirm(cmd, "This action cannot be undone. Proceed?")
+			if confirmErr != nil {
+				return confirmErr
+			}
+			if !confirmed {
 				return nil
 			}
 
@@ -13,12 +13,12 @@ package images
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
 	"github.com/spf13/cobra"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	containerregistrySdk "github.com/MagaluCloud/mgc-sdk-go/containerregistry"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -50,7 +50,7 @@ func Get(ctx context.Context, parent *cobra.Command, imagesService containerregi
 			if registryIDFlag.IsChanged() {
 				registryID = *registryIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o registry-id como argumento ou usar a flag --registry-id")
+				return cmdutils.NewMissingArgError("registry-id")
 			} // CobraFlagsAssign
 
 			if len(args) > 1 {
@@ -59,7 +59,7 @@ func Get(ctx context.Context, parent *cobra.Command, imagesService containerregi
 			if repositoryNameFlag.IsChanged() {
 				repositoryName = *repositoryNameFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o repository-name como argumento ou usar a flag --repository-name")
+				return cmdutils.NewMissingArgError("repository-name")
 			} // CobraFlagsAssign
 
 			if len(args) > 2 {
@@ -68,7 +68,7 @@ func Get(ctx context.Context, parent *cobra.Command, imagesService containerregi
 			if digestOrTagFlag.IsChanged() {
 				digestOrTag = *digestOrTagFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o digest-or-tag como argumento ou usar a flag --digest-or-tag")
+				return cmdutils.NewMissingArgError("digest-or-tag")
 			} // CobraFlagsAssign
 
 			imageresponse, err := imagesService.Get(ctx, registryID, repositoryName, digestOrTag)
@@ -13,10 +13,10 @@ package images
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	containerregistrySdk "github.com/MagaluCloud/mgc-sdk-go/containerregistry"
@@ -56,7 +56,7 @@ func List(ctx context.Context, parent *cobra.Command, imagesService containerreg
 			if registryIDFlag.IsChanged() {
 				registryID = *registryIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o registry-id como argumento ou usar a flag --registry-id")
+				return cmdutils.NewMissingArgError("registry-id")
 			} // CobraFlagsAssign
 
 			if len(args) > 1 {
@@ -65,7 +65,7 @@ func List(ctx context.Context, parent *cobra.Command, imagesService containerreg
 			if repositoryNameFlag.IsChanged() {
 				repositoryName = *repositoryNameFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o repository-name como argumento ou usar a flag --repository-name")
+				return cmdutils.NewMissingArgError("repository-name")
 			} // CobraFlagsAssign
 
 			if opts_ExpandFlag.IsChanged() {
@@ -87,11 +87,16 @@ func List(ctx context.Context, parent *cobra.Command, imagesService containerreg
 				opts.Sort = opts_SortFlag.Value
 			} // CobraFlagsAssign
 
-			imagesresponse, err := imagesService.List(ctx, registryID, repositoryName, opts)
+			imagesresponse, streamed, err := cmdutils.ListAll(ctx, cmd, &opts.Offset, &opts.Limit, func(ctx context.Context) (any, error) {
+				return imagesService.List(ctx, registryID, repositoryName, opts)
+			})
 
 			if err != nil {
 				return err
 			}
+			if streamed {
+				return nil
+			}
 
 			raw, _ := cmd.Root().PersistentFlags().GetBool("raw")
 			beautiful.NewOutput(raw).PrintData(imagesresponse)
@@ -111,6 +116,8 @@ func List(ctx context.Context, parent *cobra.Command, imagesService containerreg
 
 	repositoryNameFlag = flags.NewStr(cmd, "repository-name", "", " (required)") //CobraFlagsCreation
 
+	cmdutils.AddPaginationFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
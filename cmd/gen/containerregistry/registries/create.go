@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	containerregistrySdk "github.com/MagaluCloud/mgc-sdk-go/containerregistry"
@@ -34,10 +36,18 @@ func Create(ctx context.Context, parent *cobra.Command, registriesService contai
 
 			request := containerregistrySdk.RegistryRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &request); done || err != nil {
+				return err
+			}
+
 			if request_NameFlag.IsChanged() {
 				request.Name = *request_NameFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "RegistriesService.Create", nil, map[string]any{"request": &request}) {
+				return nil
+			}
+
 			registryresponse, err := registriesService.Create(ctx, &request)
 
 			if err != nil {
@@ -52,6 +62,8 @@ func Create(ctx context.Context, parent *cobra.Command, registriesService contai
 
 	request_NameFlag = flags.NewStr(cmd, "name", "", "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -13,9 +13,7 @@ package registries
 import (
 	"context"
 
-	"fmt"
-
-	"github.com/charmbracelet/huh"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
 
 	"github.com/spf13/cobra"
 
@@ -42,12 +40,18 @@ func Delete(ctx context.Context, parent *cobra.Command, registriesService contai
 			if registryIDFlag.IsChanged() {
 				registryID = *registryIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o registry-id como argumento ou usar a flag --registry-id")
+				return cmdutils.NewMissingArgError("registry-id")
 			} // CobraFlagsAssign
 
-			var confirm bool
-			huh.NewConfirm().Title("This action cannot be undone. Proceed?").Affirmative("Yes").Negative("No").Value(&confirm).Run()
-			if !confirm {
+			if cmdutils.DryRun(cmd, "RegistriesService.Delete", map[string]any{"registryID": registryID}, nil) {
+				return nil
+			}
+
+			confirmed, confirmErr := cmdutils.ConfirmByTyping(cmd, "The container registry and all of its data will be permanently deleted.", registryID)
+			if confirmErr != nil {
+				return confirmErr
+			}
+			if !confirmed {
 				return nil
 			}
 
@@ -13,12 +13,12 @@ package registries
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
 	"github.com/spf13/cobra"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	containerregistrySdk "github.com/MagaluCloud/mgc-sdk-go/containerregistry"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -42,7 +42,7 @@ func Get(ctx context.Context, parent *cobra.Command, registriesService container
 			if registryIDFlag.IsChanged() {
 				registryID = *registryIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o registry-id como argumento ou usar a flag --registry-id")
+				return cmdutils.NewMissingArgError("registry-id")
 			} // CobraFlagsAssign
 
 			registryresponse, err := registriesService.Get(ctx, registryID)
@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	containerregistrySdk "github.com/MagaluCloud/mgc-sdk-go/containerregistry"
@@ -50,11 +52,16 @@ func List(ctx context.Context, parent *cobra.Command, registriesService containe
 				opts.Sort = opts_SortFlag.Value
 			} // CobraFlagsAssign
 
-			listregistriesresponse, err := registriesService.List(ctx, opts)
+			listregistriesresponse, streamed, err := cmdutils.ListAll(ctx, cmd, &opts.Offset, &opts.Limit, func(ctx context.Context) (any, error) {
+				return registriesService.List(ctx, opts)
+			})
 
 			if err != nil {
 				return err
 			}
+			if streamed {
+				return nil
+			}
 
 			raw, _ := cmd.Root().PersistentFlags().GetBool("raw")
 			beautiful.NewOutput(raw).PrintData(listregistriesresponse)
@@ -68,6 +75,9 @@ func List(ctx context.Context, parent *cobra.Command, registriesService containe
 
 	opts_SortFlag = flags.NewStr(cmd, "sort", "", "") //CobraFlagsCreation
 
+	cmdutils.AddPaginationFlags(cmd) //CobraFlagsCreation
+	cmdutils.AddAllRegionsFlag(cmd)  //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
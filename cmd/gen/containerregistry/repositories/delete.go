@@ -13,9 +13,7 @@ package repositories
 import (
 	"context"
 
-	"fmt"
-
-	"github.com/charmbracelet/huh"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
 
 	"github.com/spf13/cobra"
 
@@ -46,7 +44,7 @@ func Delete(ctx context.Context, parent *cobra.Command, repositoriesService cont
 			if registryIDFlag.IsChanged() {
 				registryID = *registryIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o registry-id como argumento ou usar a flag --registry-id")
+				return cmdutils.NewMissingArgError("registry-id")
 			} // CobraFlagsAssign
 
 			if len(args) > 1 {
@@ -55,12 +53,18 @@ func Delete(ctx context.Context, parent *cobra.Command, repositoriesService cont
 			if repositoryNameFlag.IsChanged() {
 				repositoryName = *repositoryNameFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o repository-name como argumento ou usar a flag --repository-name")
+				return cmdutils.NewMissingArgError("repository-name")
 			} // CobraFlagsAssign
 
-			var confirm bool
-			huh.NewConfirm().Title("This action cannot be undone. Proceed?").Affirmative("Yes").Negative("No").Value(&confirm).Run()
-			if !confirm {
+			if cmdutils.DryRun(cmd, "RepositoriesService.Delete", map[string]any{"registryID": registryID, "repositoryName": repositoryName}, nil) {
+				return nil
+			}
+
+			confirmed, confirmErr := cmdutils.Confirm(cmd, "This action cannot be undone. Proceed?")
+			if confirmErr != nil {
+				return confirmErr
+			}
+			if !confirmed {
 				return nil
 			}
 
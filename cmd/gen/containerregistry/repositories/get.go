@@ -13,12 +13,12 @@ package repositories
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
 	"github.com/spf13/cobra"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	containerregistrySdk "github.com/MagaluCloud/mgc-sdk-go/containerregistry"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -46,7 +46,7 @@ func Get(ctx context.Context, parent *cobra.Command, repositoriesService contain
 			if registryIDFlag.IsChanged() {
 				registryID = *registryIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o registry-id como argumento ou usar a flag --registry-id")
+				return cmdutils.NewMissingArgError("registry-id")
 			} // CobraFlagsAssign
 
 			if len(args) > 1 {
@@ -55,7 +55,7 @@ func Get(ctx context.Context, parent *cobra.Command, repositoriesService contain
 			if repositoryNameFlag.IsChanged() {
 				repositoryName = *repositoryNameFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o repository-name como argumento ou usar a flag --repository-name")
+				return cmdutils.NewMissingArgError("repository-name")
 			} // CobraFlagsAssign
 
 			repositoryresponse, err := repositoriesService.Get(ctx, registryID, repositoryName)
@@ -13,10 +13,10 @@ package repositories
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	containerregistrySdk "github.com/MagaluCloud/mgc-sdk-go/containerregistry"
@@ -50,7 +50,7 @@ func List(ctx context.Context, parent *cobra.Command, repositoriesService contai
 			if registryIDFlag.IsChanged() {
 				registryID = *registryIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o registry-id como argumento ou usar a flag --registry-id")
+				return cmdutils.NewMissingArgError("registry-id")
 			} // CobraFlagsAssign
 
 			if opts_LimitFlag.IsChanged() {
@@ -65,11 +65,16 @@ func List(ctx context.Context, parent *cobra.Command, repositoriesService contai
 				opts.Sort = opts_SortFlag.Value
 			} // CobraFlagsAssign
 
-			repositoriesresponse, err := repositoriesService.List(ctx, registryID, opts)
+			repositoriesresponse, streamed, err := cmdutils.ListAll(ctx, cmd, &opts.Offset, &opts.Limit, func(ctx context.Context) (any, error) {
+				return repositoriesService.List(ctx, registryID, opts)
+			})
 
 			if err != nil {
 				return err
 			}
+			if streamed {
+				return nil
+			}
 
 			raw, _ := cmd.Root().PersistentFlags().GetBool("raw")
 			beautiful.NewOutput(raw).PrintData(repositoriesresponse)
@@ -85,6 +90,8 @@ func List(ctx context.Context, parent *cobra.Command, repositoriesService contai
 
 	registryIDFlag = flags.NewStr(cmd, "registry-id", "", " (required)") //CobraFlagsCreation
 
+	cmdutils.AddPaginationFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
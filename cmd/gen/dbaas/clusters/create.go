@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	dbaasSdk "github.com/MagaluCloud/mgc-sdk-go/dbaas"
@@ -52,6 +54,10 @@ func Create(ctx context.Context, parent *cobra.Command, clusterService dbaasSdk.
 
 			req := dbaasSdk.ClusterCreateRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			if req_BackupRetentionDaysFlag.IsChanged() {
 				req.BackupRetentionDays = req_BackupRetentionDaysFlag.Value
 			} // CobraFlagsAssign
@@ -92,6 +98,10 @@ func Create(ctx context.Context, parent *cobra.Command, clusterService dbaasSdk.
 				req.Volume.Type = req_Volume_TypeFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "ClusterService.Create", nil, map[string]any{"req": req}) {
+				return nil
+			}
+
 			clusterresponse, err := clusterService.Create(ctx, req)
 
 			if err != nil {
@@ -124,6 +134,8 @@ func Create(ctx context.Context, parent *cobra.Command, clusterService dbaasSdk.
 
 	req_Volume_TypeFlag = flags.NewStr(cmd, "volume.type", "", "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
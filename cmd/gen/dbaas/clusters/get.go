@@ -13,12 +13,12 @@ package clusters
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
 	"github.com/spf13/cobra"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	dbaasSdk "github.com/MagaluCloud/mgc-sdk-go/dbaas"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -42,7 +42,7 @@ func Get(ctx context.Context, parent *cobra.Command, clusterService dbaasSdk.Clu
 			if IDFlag.IsChanged() {
 				ID = *IDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o id como argumento ou usar a flag --id")
+				return cmdutils.NewMissingArgError("id")
 			} // CobraFlagsAssign
 
 			clusterdetailresponse, err := clusterService.Get(ctx, ID)
@@ -13,10 +13,10 @@ package clusters
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	dbaasSdk "github.com/MagaluCloud/mgc-sdk-go/dbaas"
@@ -42,9 +42,13 @@ func Stop(ctx context.Context, parent *cobra.Command, clusterService dbaasSdk.Cl
 			if IDFlag.IsChanged() {
 				ID = *IDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o id como argumento ou usar a flag --id")
+				return cmdutils.NewMissingArgError("id")
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "ClusterService.Stop", map[string]any{"ID": ID}, nil) {
+				return nil
+			}
+
 			clusterdetailresponse, err := clusterService.Stop(ctx, ID)
 
 			if err != nil {
@@ -13,10 +13,10 @@ package clusters
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	dbaasSdk "github.com/MagaluCloud/mgc-sdk-go/dbaas"
@@ -42,6 +42,10 @@ func Update(ctx context.Context, parent *cobra.Command, clusterService dbaasSdk.
 
 			req := dbaasSdk.ClusterUpdateRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var ID string // ServiceSDKParamCreate
 
 			if len(args) > 0 {
@@ -50,7 +54,7 @@ func Update(ctx context.Context, parent *cobra.Command, clusterService dbaasSdk.
 			if IDFlag.IsChanged() {
 				ID = *IDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o id como argumento ou usar a flag --id")
+				return cmdutils.NewMissingArgError("id")
 			} // CobraFlagsAssign
 
 			if req_BackupRetentionDaysFlag.IsChanged() {
@@ -65,6 +69,10 @@ func Update(ctx context.Context, parent *cobra.Command, clusterService dbaasSdk.
 				req.ParameterGroupID = req_ParameterGroupIDFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "ClusterService.Update", map[string]any{"ID": ID}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			clusterdetailresponse, err := clusterService.Update(ctx, ID, req)
 
 			if err != nil {
@@ -85,6 +93,8 @@ func Update(ctx context.Context, parent *cobra.Command, clusterService dbaasSdk.
 
 	req_ParameterGroupIDFlag = flags.NewStr(cmd, "parameter-group-id", "", "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -13,10 +13,10 @@ package engines
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	dbaasSdk "github.com/MagaluCloud/mgc-sdk-go/dbaas"
@@ -52,7 +52,7 @@ func ListEngineParameters(ctx context.Context, parent *cobra.Command, engineServ
 			if engineIDFlag.IsChanged() {
 				engineID = *engineIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o engine-id como argumento ou usar a flag --engine-id")
+				return cmdutils.NewMissingArgError("engine-id")
 			} // CobraFlagsAssign
 
 			if opts_DynamicFlag.IsChanged() {
@@ -71,11 +71,16 @@ func ListEngineParameters(ctx context.Context, parent *cobra.Command, engineServ
 				opts.Offset = opts_OffsetFlag.Value
 			} // CobraFlagsAssign
 
-			engineparameterdetail, err := engineService.ListEngineParameters(ctx, engineID, opts)
+			engineparameterdetail, streamed, err := cmdutils.ListAll(ctx, cmd, &opts.Offset, &opts.Limit, func(ctx context.Context) (any, error) {
+				return engineService.ListEngineParameters(ctx, engineID, opts)
+			})
 
 			if err != nil {
 				return err
 			}
+			if streamed {
+				return nil
+			}
 
 			raw, _ := cmd.Root().PersistentFlags().GetBool("raw")
 			beautiful.NewOutput(raw).PrintData(engineparameterdetail)
@@ -93,6 +98,8 @@ func ListEngineParameters(ctx context.Context, parent *cobra.Command, engineServ
 
 	opts_OffsetFlag = flags.NewInt(cmd, "offset", 0, "") //CobraFlagsCreation
 
+	cmdutils.AddPaginationFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
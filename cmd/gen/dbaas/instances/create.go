@@ -17,6 +17,8 @@ import (
 
 	"github.com/spf13/cobra"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	dbaasSdk "github.com/MagaluCloud/mgc-sdk-go/dbaas"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -54,6 +56,10 @@ func Create(ctx context.Context, parent *cobra.Command, instanceService dbaasSdk
 
 			req := dbaasSdk.InstanceCreateRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			if req_AvailabilityZoneFlag.IsChanged() {
 				req.AvailabilityZone = req_AvailabilityZoneFlag.Value
 			} // CobraFlagsAssign
@@ -98,12 +104,20 @@ func Create(ctx context.Context, parent *cobra.Command, instanceService dbaasSdk
 				req.Volume.Type = *req_Volume_TypeFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "InstanceService.Create", nil, map[string]any{"req": req}) {
+				return nil
+			}
+
 			instanceresponse, err := instanceService.Create(ctx, req)
 
 			if err != nil {
 				return err
 			}
 
+			if err := waitInstance(ctx, cmd, instanceService, instanceresponse.ID, string(dbaasSdk.InstanceStatusActive)); err != nil {
+				return err
+			}
+
 			raw, _ := cmd.Root().PersistentFlags().GetBool("raw")
 			beautiful.NewOutput(raw).PrintData(instanceresponse)
 			return nil
@@ -132,6 +146,10 @@ func Create(ctx context.Context, parent *cobra.Command, instanceService dbaasSdk
 
 	req_Volume_TypeFlag = flags.NewStr(cmd, "volume.type", "", "") //CobraFlagsCreation
 
+	cmdutils.AddWaitFlags(cmd) //CobraFlagsCreation
+
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -13,10 +13,10 @@ package instances
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	dbaasSdk "github.com/MagaluCloud/mgc-sdk-go/dbaas"
@@ -40,6 +40,10 @@ func CreateSnapshot(ctx context.Context, parent *cobra.Command, instanceService
 
 			req := dbaasSdk.SnapshotCreateRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var instanceID string // ServiceSDKParamCreate
 
 			if len(args) > 0 {
@@ -48,7 +52,7 @@ func CreateSnapshot(ctx context.Context, parent *cobra.Command, instanceService
 			if instanceIDFlag.IsChanged() {
 				instanceID = *instanceIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o instance-id como argumento ou usar a flag --instance-id")
+				return cmdutils.NewMissingArgError("instance-id")
 			} // CobraFlagsAssign
 
 			if req_DescriptionFlag.IsChanged() {
@@ -59,6 +63,10 @@ func CreateSnapshot(ctx context.Context, parent *cobra.Command, instanceService
 				req.Name = *req_NameFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "InstanceService.CreateSnapshot", map[string]any{"instanceID": instanceID}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			snapshotresponse, err := instanceService.CreateSnapshot(ctx, instanceID, req)
 
 			if err != nil {
@@ -77,6 +85,8 @@ func CreateSnapshot(ctx context.Context, parent *cobra.Command, instanceService
 
 	req_NameFlag = flags.NewStr(cmd, "name", "", "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
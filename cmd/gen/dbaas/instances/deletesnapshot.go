@@ -13,9 +13,7 @@ package instances
 import (
 	"context"
 
-	"fmt"
-
-	"github.com/charmbracelet/huh"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
 
 	"github.com/spf13/cobra"
 
@@ -46,7 +44,7 @@ func DeleteSnapshot(ctx context.Context, parent *cobra.Command, instanceService
 			if instanceIDFlag.IsChanged() {
 				instanceID = *instanceIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o instance-id como argumento ou usar a flag --instance-id")
+				return cmdutils.NewMissingArgError("instance-id")
 			} // CobraFlagsAssign
 
 			if len(args) > 1 {
@@ -55,12 +53,18 @@ func DeleteSnapshot(ctx context.Context, parent *cobra.Command, instanceService
 			if snapshotIDFlag.IsChanged() {
 				snapshotID = *snapshotIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o snapshot-id como argumento ou usar a flag --snapshot-id")
+				return cmdutils.NewMissingArgError("snapshot-id")
 			} // CobraFlagsAssign
 
-			var confirm bool
-			huh.NewConfirm().Title("This action cannot be undone. Proceed?").Affirmative("Yes").Negative("No").Value(&confirm).Run()
-			if !confirm {
+			if cmdutils.DryRun(cmd, "InstanceService.DeleteSnapshot", map[string]any{"instanceID": instanceID, "snapshotID": snapshotID}, nil) {
+				return nil
+			}
+
+			confirmed, confirmErr := cmdutils.Confirm(cmd, "This action cannot be undone. Proceed?")
+			if confirmErr != nil {
+				return confirmErr
+			}
+			if !confirmed {
 				return nil
 			}
 
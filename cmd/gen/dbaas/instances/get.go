@@ -13,12 +13,12 @@ package instances
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
 	"github.com/spf13/cobra"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	dbaasSdk "github.com/MagaluCloud/mgc-sdk-go/dbaas"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -46,7 +46,7 @@ func Get(ctx context.Context, parent *cobra.Command, instanceService dbaasSdk.In
 			if idFlag.IsChanged() {
 				id = *idFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o id como argumento ou usar a flag --id")
+				return cmdutils.NewMissingArgError("id")
 			} // CobraFlagsAssign
 
 			if opts_ExpandedFieldsFlag.IsChanged() {
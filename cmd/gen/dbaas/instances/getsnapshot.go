@@ -13,12 +13,12 @@ package instances
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
 	"github.com/spf13/cobra"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	dbaasSdk "github.com/MagaluCloud/mgc-sdk-go/dbaas"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -46,7 +46,7 @@ func GetSnapshot(ctx context.Context, parent *cobra.Command, instanceService dba
 			if instanceIDFlag.IsChanged() {
 				instanceID = *instanceIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o instance-id como argumento ou usar a flag --instance-id")
+				return cmdutils.NewMissingArgError("instance-id")
 			} // CobraFlagsAssign
 
 			if len(args) > 1 {
@@ -55,7 +55,7 @@ func GetSnapshot(ctx context.Context, parent *cobra.Command, instanceService dba
 			if snapshotIDFlag.IsChanged() {
 				snapshotID = *snapshotIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o snapshot-id como argumento ou usar a flag --snapshot-id")
+				return cmdutils.NewMissingArgError("snapshot-id")
 			} // CobraFlagsAssign
 
 			snapshotdetailresponse, err := instanceService.GetSnapshot(ctx, instanceID, snapshotID)
@@ -51,5 +51,7 @@ func InstancesCmd(ctx context.Context, parent *cobra.Command, instanceService db
 
 	UpdateSnapshot(ctx, cmd, instanceService)
 
+	Wait(ctx, cmd, instanceService)
+
 	parent.AddCommand(cmd)
 }
@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	dbaasSdk "github.com/MagaluCloud/mgc-sdk-go/dbaas"
@@ -92,11 +94,16 @@ func List(ctx context.Context, parent *cobra.Command, instanceService dbaasSdk.I
 				opts.VolumeSizeLte = opts_VolumeSizeLteFlag.Value
 			} // CobraFlagsAssign
 
-			instancesresponse, err := instanceService.List(ctx, opts)
+			instancesresponse, streamed, err := cmdutils.ListAll(ctx, cmd, &opts.Offset, &opts.Limit, func(ctx context.Context) (any, error) {
+				return instanceService.List(ctx, opts)
+			})
 
 			if err != nil {
 				return err
 			}
+			if streamed {
+				return nil
+			}
 
 			raw, _ := cmd.Root().PersistentFlags().GetBool("raw")
 			beautiful.NewOutput(raw).PrintData(instancesresponse)
@@ -124,6 +131,9 @@ func List(ctx context.Context, parent *cobra.Command, instanceService dbaasSdk.I
 
 	opts_VolumeSizeLteFlag = flags.NewInt(cmd, "volume-size-lte", 0, "") //CobraFlagsCreation
 
+	cmdutils.AddPaginationFlags(cmd) //CobraFlagsCreation
+	cmdutils.AddAllRegionsFlag(cmd)  //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -13,12 +13,12 @@ package instances
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
 	"github.com/spf13/cobra"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	dbaasSdk "github.com/MagaluCloud/mgc-sdk-go/dbaas"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -56,7 +56,7 @@ func ListAllSnapshots(ctx context.Context, parent *cobra.Command, instanceServic
 			if instanceIDFlag.IsChanged() {
 				instanceID = *instanceIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o instance-id como argumento ou usar a flag --instance-id")
+				return cmdutils.NewMissingArgError("instance-id")
 			} // CobraFlagsAssign
 
 			snapshotdetailresponse, err := instanceService.ListAllSnapshots(ctx, instanceID, filterOpts)
@@ -13,10 +13,10 @@ package instances
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	dbaasSdk "github.com/MagaluCloud/mgc-sdk-go/dbaas"
@@ -52,7 +52,7 @@ func ListSnapshots(ctx context.Context, parent *cobra.Command, instanceService d
 			if instanceIDFlag.IsChanged() {
 				instanceID = *instanceIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o instance-id como argumento ou usar a flag --instance-id")
+				return cmdutils.NewMissingArgError("instance-id")
 			} // CobraFlagsAssign
 
 			if opts_LimitFlag.IsChanged() {
@@ -71,11 +71,16 @@ func ListSnapshots(ctx context.Context, parent *cobra.Command, instanceService d
 				opts.Type = (*dbaasSdk.SnapshotType)(opts_TypeFlag.Value)
 			} // CobraFlagsAssign
 
-			snapshotsresponse, err := instanceService.ListSnapshots(ctx, instanceID, opts)
+			snapshotsresponse, streamed, err := cmdutils.ListAll(ctx, cmd, &opts.Offset, &opts.Limit, func(ctx context.Context) (any, error) {
+				return instanceService.ListSnapshots(ctx, instanceID, opts)
+			})
 
 			if err != nil {
 				return err
 			}
+			if streamed {
+				return nil
+			}
 
 			raw, _ := cmd.Root().PersistentFlags().GetBool("raw")
 			beautiful.NewOutput(raw).PrintData(snapshotsresponse)
@@ -93,6 +98,8 @@ func ListSnapshots(ctx context.Context, parent *cobra.Command, instanceService d
 
 	opts_TypeFlag = flags.NewStr(cmd, "type", "", "") //CobraFlagsCreation
 
+	cmdutils.AddPaginationFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -13,12 +13,12 @@ package instances
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
 	"github.com/spf13/cobra"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	dbaasSdk "github.com/MagaluCloud/mgc-sdk-go/dbaas"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -38,9 +38,15 @@ func Resize(ctx context.Context, parent *cobra.Command, instanceService dbaasSdk
 
 			req := dbaasSdk.InstanceResizeRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var id string // ServiceSDKParamCreate
 
-			req.Volume = &dbaasSdk.InstanceVolumeResizeRequest{} //CobraStructInitialize
+			if req.Volume == nil {
+				req.Volume = &dbaasSdk.InstanceVolumeResizeRequest{}
+			} //CobraStructInitialize
 
 			if len(args) > 0 {
 				cmd.Flags().Set("id", args[0])
@@ -48,19 +54,27 @@ func Resize(ctx context.Context, parent *cobra.Command, instanceService dbaasSdk
 			if idFlag.IsChanged() {
 				id = *idFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o id como argumento ou usar a flag --id")
+				return cmdutils.NewMissingArgError("id")
 			} // CobraFlagsAssign
 
 			if req_InstanceTypeIDFlag.IsChanged() {
 				req.InstanceTypeID = req_InstanceTypeIDFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "InstanceService.Resize", map[string]any{"id": id}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			instancedetail, err := instanceService.Resize(ctx, id, req)
 
 			if err != nil {
 				return err
 			}
 
+			if err := waitInstanceResize(ctx, cmd, instanceService, id, req, string(dbaasSdk.InstanceStatusActive)); err != nil {
+				return err
+			}
+
 			raw, _ := cmd.Root().PersistentFlags().GetBool("raw")
 			beautiful.NewOutput(raw).PrintData(instancedetail)
 			return nil
@@ -71,6 +85,10 @@ func Resize(ctx context.Context, parent *cobra.Command, instanceService dbaasSdk
 
 	req_InstanceTypeIDFlag = flags.NewStr(cmd, "instance-type-id", "", "") //CobraFlagsCreation
 
+	cmdutils.AddWaitFlags(cmd) //CobraFlagsCreation
+
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
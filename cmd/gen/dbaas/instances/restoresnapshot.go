@@ -13,10 +13,10 @@ package instances
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	dbaasSdk "github.com/MagaluCloud/mgc-sdk-go/dbaas"
@@ -46,11 +46,17 @@ func RestoreSnapshot(ctx context.Context, parent *cobra.Command, instanceService
 
 			req := dbaasSdk.RestoreSnapshotRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var instanceID string // ServiceSDKParamCreate
 
 			var snapshotID string // ServiceSDKParamCreate
 
-			req.Volume = &dbaasSdk.InstanceVolumeRequest{} //CobraStructInitialize
+			if req.Volume == nil {
+				req.Volume = &dbaasSdk.InstanceVolumeRequest{}
+			} //CobraStructInitialize
 
 			if len(args) > 0 {
 				cmd.Flags().Set("instance-id", args[0])
@@ -58,7 +64,7 @@ func RestoreSnapshot(ctx context.Context, parent *cobra.Command, instanceService
 			if instanceIDFlag.IsChanged() {
 				instanceID = *instanceIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o instance-id como argumento ou usar a flag --instance-id")
+				return cmdutils.NewMissingArgError("instance-id")
 			} // CobraFlagsAssign
 
 			if len(args) > 1 {
@@ -67,7 +73,7 @@ func RestoreSnapshot(ctx context.Context, parent *cobra.Command, instanceService
 			if snapshotIDFlag.IsChanged() {
 				snapshotID = *snapshotIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o snapshot-id como argumento ou usar a flag --snapshot-id")
+				return cmdutils.NewMissingArgError("snapshot-id")
 			} // CobraFlagsAssign
 
 			if req_BackupRetentionDaysFlag.IsChanged() {
@@ -86,6 +92,10 @@ func RestoreSnapshot(ctx context.Context, parent *cobra.Command, instanceService
 				req.Name = *req_NameFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "InstanceService.RestoreSnapshot", map[string]any{"instanceID": instanceID, "snapshotID": snapshotID}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			instanceresponse, err := instanceService.RestoreSnapshot(ctx, instanceID, snapshotID, req)
 
 			if err != nil {
@@ -110,6 +120,8 @@ func RestoreSnapshot(ctx context.Context, parent *cobra.Command, instanceService
 
 	snapshotIDFlag = flags.NewStr(cmd, "snapshot-id", "", " (required)") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
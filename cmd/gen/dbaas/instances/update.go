@@ -13,10 +13,10 @@ package instances
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	dbaasSdk "github.com/MagaluCloud/mgc-sdk-go/dbaas"
@@ -42,6 +42,10 @@ func Update(ctx context.Context, parent *cobra.Command, instanceService dbaasSdk
 
 			req := dbaasSdk.DatabaseInstanceUpdateRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var id string // ServiceSDKParamCreate
 
 			if len(args) > 0 {
@@ -50,7 +54,7 @@ func Update(ctx context.Context, parent *cobra.Command, instanceService dbaasSdk
 			if idFlag.IsChanged() {
 				id = *idFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o id como argumento ou usar a flag --id")
+				return cmdutils.NewMissingArgError("id")
 			} // CobraFlagsAssign
 
 			if req_BackupRetentionDaysFlag.IsChanged() {
@@ -65,6 +69,10 @@ func Update(ctx context.Context, parent *cobra.Command, instanceService dbaasSdk
 				req.ParameterGroupID = req_ParameterGroupIDFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "InstanceService.Update", map[string]any{"id": id}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			instancedetail, err := instanceService.Update(ctx, id, req)
 
 			if err != nil {
@@ -85,6 +93,8 @@ func Update(ctx context.Context, parent *cobra.Command, instanceService dbaasSdk
 
 	req_ParameterGroupIDFlag = flags.NewStr(cmd, "parameter-group-id", "", "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
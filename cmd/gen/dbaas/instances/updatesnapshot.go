@@ -13,10 +13,10 @@ package instances
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	dbaasSdk "github.com/MagaluCloud/mgc-sdk-go/dbaas"
@@ -42,6 +42,10 @@ func UpdateSnapshot(ctx context.Context, parent *cobra.Command, instanceService
 
 			req := dbaasSdk.SnapshotUpdateRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var instanceID string // ServiceSDKParamCreate
 
 			var snapshotID string // ServiceSDKParamCreate
@@ -52,7 +56,7 @@ func UpdateSnapshot(ctx context.Context, parent *cobra.Command, instanceService
 			if instanceIDFlag.IsChanged() {
 				instanceID = *instanceIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o instance-id como argumento ou usar a flag --instance-id")
+				return cmdutils.NewMissingArgError("instance-id")
 			} // CobraFlagsAssign
 
 			if len(args) > 1 {
@@ -61,7 +65,7 @@ func UpdateSnapshot(ctx context.Context, parent *cobra.Command, instanceService
 			if snapshotIDFlag.IsChanged() {
 				snapshotID = *snapshotIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o snapshot-id como argumento ou usar a flag --snapshot-id")
+				return cmdutils.NewMissingArgError("snapshot-id")
 			} // CobraFlagsAssign
 
 			if req_DescriptionFlag.IsChanged() {
@@ -72,6 +76,10 @@ func UpdateSnapshot(ctx context.Context, parent *cobra.Command, instanceService
 				req.Name = *req_NameFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "InstanceService.UpdateSnapshot", map[string]any{"instanceID": instanceID, "snapshotID": snapshotID}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			snapshotdetailresponse, err := instanceService.UpdateSnapshot(ctx, instanceID, snapshotID, req)
 
 			if err != nil {
@@ -92,6 +100,8 @@ func UpdateSnapshot(ctx context.Context, parent *cobra.Command, instanceService
 
 	snapshotIDFlag = flags.NewStr(cmd, "snapshot-id", "", " (required)") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -0,0 +1,63 @@
+package instances
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	dbaasSdk "github.com/MagaluCloud/mgc-sdk-go/dbaas"
+
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+)
+
+// instanceStatus retorna o status atual da instância de banco de dados
+func instanceStatus(instanceService dbaasSdk.InstanceService) func(ctx context.Context, id string) ([]string, error) {
+	return func(ctx context.Context, id string) ([]string, error) {
+		instance, err := instanceService.Get(ctx, id, dbaasSdk.GetInstanceOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return []string{string(instance.Status)}, nil
+	}
+}
+
+// waitInstance aguarda a instância atingir um dos status quando --wait é usado
+func waitInstance(ctx context.Context, cmd *cobra.Command, instanceService dbaasSdk.InstanceService, id string, target ...string) error {
+	if !cmdutils.ShouldWait(cmd) {
+		return nil
+	}
+	opts := cmdutils.NewWaitOptions(cmd, "database instance "+id, target...)
+	return cmdutils.WaitForStatus(ctx, opts, func(ctx context.Context) ([]string, error) {
+		return instanceStatus(instanceService)(ctx, id)
+	})
+}
+
+// waitInstanceResize aguarda o fim do resize: a instância já está ACTIVE antes
+// da operação, então só é considerada pronta depois de passar por um status
+// transitório ou de exibir o novo tipo e o novo tamanho de volume
+func waitInstanceResize(ctx context.Context, cmd *cobra.Command, instanceService dbaasSdk.InstanceService, id string, req dbaasSdk.InstanceResizeRequest, target ...string) error {
+	if !cmdutils.ShouldWait(cmd) {
+		return nil
+	}
+	opts := cmdutils.NewWaitOptions(cmd, "database instance "+id, target...)
+	opts.Applied = func(ctx context.Context) (bool, error) {
+		instance, err := instanceService.Get(ctx, id, dbaasSdk.GetInstanceOptions{})
+		if err != nil {
+			return false, err
+		}
+		if req.InstanceTypeID != nil && instance.InstanceTypeID != *req.InstanceTypeID {
+			return false, nil
+		}
+		if req.Volume != nil && instance.Volume.Size < req.Volume.Size {
+			return false, nil
+		}
+		return true, nil
+	}
+	return cmdutils.WaitForStatus(ctx, opts, func(ctx context.Context) ([]string, error) {
+		return instanceStatus(instanceService)(ctx, id)
+	})
+}
+
+func Wait(ctx context.Context, parent *cobra.Command, instanceService dbaasSdk.InstanceService) {
+	parent.AddCommand(cmdutils.NewWaitCmd(ctx, "database instance", string(dbaasSdk.InstanceStatusActive), instanceStatus(instanceService)))
+}
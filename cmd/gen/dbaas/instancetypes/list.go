@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	dbaasSdk "github.com/MagaluCloud/mgc-sdk-go/dbaas"
@@ -62,11 +64,16 @@ func List(ctx context.Context, parent *cobra.Command, instanceTypeService dbaasS
 				opts.Status = opts_StatusFlag.Value
 			} // CobraFlagsAssign
 
-			listinstancetypesresponse, err := instanceTypeService.List(ctx, opts)
+			listinstancetypesresponse, streamed, err := cmdutils.ListAll(ctx, cmd, &opts.Offset, &opts.Limit, func(ctx context.Context) (any, error) {
+				return instanceTypeService.List(ctx, opts)
+			})
 
 			if err != nil {
 				return err
 			}
+			if streamed {
+				return nil
+			}
 
 			raw, _ := cmd.Root().PersistentFlags().GetBool("raw")
 			beautiful.NewOutput(raw).PrintData(listinstancetypesresponse)
@@ -84,6 +91,9 @@ func List(ctx context.Context, parent *cobra.Command, instanceTypeService dbaasS
 
 	opts_StatusFlag = flags.NewStr(cmd, "status", "", "") //CobraFlagsCreation
 
+	cmdutils.AddPaginationFlags(cmd) //CobraFlagsCreation
+	cmdutils.AddAllRegionsFlag(cmd)  //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	dbaasSdk "github.com/MagaluCloud/mgc-sdk-go/dbaas"
@@ -36,6 +38,10 @@ func Create(ctx context.Context, parent *cobra.Command, parameterService dbaasSd
 
 			req := dbaasSdk.ParameterCreateRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var groupID string // ServiceSDKParamCreate
 
 			if groupIDFlag.IsChanged() {
@@ -46,6 +52,10 @@ func Create(ctx context.Context, parent *cobra.Command, parameterService dbaasSd
 				req.Name = *req_NameFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "ParameterService.Create", map[string]any{"groupID": groupID}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			parameterresponse, err := parameterService.Create(ctx, groupID, req)
 
 			if err != nil {
@@ -62,6 +72,8 @@ func Create(ctx context.Context, parent *cobra.Command, parameterService dbaasSd
 
 	req_NameFlag = flags.NewStr(cmd, "name", "", "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
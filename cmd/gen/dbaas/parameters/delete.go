@@ -13,9 +13,7 @@ package parameters
 import (
 	"context"
 
-	"fmt"
-
-	"github.com/charmbracelet/huh"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
 
 	"github.com/spf13/cobra"
 
@@ -46,7 +44,7 @@ func Delete(ctx context.Context, parent *cobra.Command, parameterService dbaasSd
 			if groupIDFlag.IsChanged() {
 				groupID = *groupIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o group-id como argumento ou usar a flag --group-id")
+				return cmdutils.NewMissingArgError("group-id")
 			} // CobraFlagsAssign
 
 			if len(args) > 1 {
@@ -55,12 +53,18 @@ func Delete(ctx context.Context, parent *cobra.Command, parameterService dbaasSd
 			if parameterIDFlag.IsChanged() {
 				parameterID = *parameterIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o parameter-id como argumento ou usar a flag --parameter-id")
+				return cmdutils.NewMissingArgError("parameter-id")
 			} // CobraFlagsAssign
 
-			var confirm bool
-			huh.NewConfirm().Title("This action cannot be undone. Proceed?").Affirmative("Yes").Negative("No").Value(&confirm).Run()
-			if !confirm {
+			if cmdutils.DryRun(cmd, "ParameterService.Delete", map[string]any{"groupID": groupID, "parameterID": parameterID}, nil) {
+				return nil
+			}
+
+			confirmed, confirmErr := cmdutils.Confirm(cmd, "This action cannot be undone. Proceed?")
+			if confirmErr != nil {
+				return confirmErr
+			}
+			if !confirmed {
 				return nil
 			}
 
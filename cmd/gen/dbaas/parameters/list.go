@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	dbaasSdk "github.com/MagaluCloud/mgc-sdk-go/dbaas"
@@ -50,11 +52,16 @@ func List(ctx context.Context, parent *cobra.Command, parameterService dbaasSdk.
 				opts.ParameterGroupID = *opts_ParameterGroupIDFlag.Value
 			} // CobraFlagsAssign
 
-			parametersresponse, err := parameterService.List(ctx, opts)
+			parametersresponse, streamed, err := cmdutils.ListAll(ctx, cmd, &opts.Offset, &opts.Limit, func(ctx context.Context) (any, error) {
+				return parameterService.List(ctx, opts)
+			})
 
 			if err != nil {
 				return err
 			}
+			if streamed {
+				return nil
+			}
 
 			raw, _ := cmd.Root().PersistentFlags().GetBool("raw")
 			beautiful.NewOutput(raw).PrintData(parametersresponse)
@@ -68,6 +75,9 @@ func List(ctx context.Context, parent *cobra.Command, parameterService dbaasSdk.
 
 	opts_ParameterGroupIDFlag = flags.NewStr(cmd, "parameter-group-id", "", "") //CobraFlagsCreation
 
+	cmdutils.AddPaginationFlags(cmd) //CobraFlagsCreation
+	cmdutils.AddAllRegionsFlag(cmd)  //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
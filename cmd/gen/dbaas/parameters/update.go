@@ -13,10 +13,10 @@ package parameters
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	dbaasSdk "github.com/MagaluCloud/mgc-sdk-go/dbaas"
@@ -38,6 +38,10 @@ func Update(ctx context.Context, parent *cobra.Command, parameterService dbaasSd
 
 			req := dbaasSdk.ParameterUpdateRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var groupID string // ServiceSDKParamCreate
 
 			var parameterID string // ServiceSDKParamCreate
@@ -48,7 +52,7 @@ func Update(ctx context.Context, parent *cobra.Command, parameterService dbaasSd
 			if groupIDFlag.IsChanged() {
 				groupID = *groupIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o group-id como argumento ou usar a flag --group-id")
+				return cmdutils.NewMissingArgError("group-id")
 			} // CobraFlagsAssign
 
 			if len(args) > 1 {
@@ -57,9 +61,13 @@ func Update(ctx context.Context, parent *cobra.Command, parameterService dbaasSd
 			if parameterIDFlag.IsChanged() {
 				parameterID = *parameterIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o parameter-id como argumento ou usar a flag --parameter-id")
+				return cmdutils.NewMissingArgError("parameter-id")
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "ParameterService.Update", map[string]any{"groupID": groupID, "parameterID": parameterID}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			parameterdetailresponse, err := parameterService.Update(ctx, groupID, parameterID, req)
 
 			if err != nil {
@@ -76,6 +84,8 @@ func Update(ctx context.Context, parent *cobra.Command, parameterService dbaasSd
 
 	parameterIDFlag = flags.NewStr(cmd, "parameter-id", "", " (required)") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	dbaasSdk "github.com/MagaluCloud/mgc-sdk-go/dbaas"
@@ -38,6 +40,10 @@ func Create(ctx context.Context, parent *cobra.Command, parameterGroupService db
 
 			req := dbaasSdk.ParameterGroupCreateRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			if req_DescriptionFlag.IsChanged() {
 				req.Description = req_DescriptionFlag.Value
 			} // CobraFlagsAssign
@@ -50,6 +56,10 @@ func Create(ctx context.Context, parent *cobra.Command, parameterGroupService db
 				req.Name = *req_NameFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "ParameterGroupService.Create", nil, map[string]any{"req": req}) {
+				return nil
+			}
+
 			parametergroupresponse, err := parameterGroupService.Create(ctx, req)
 
 			if err != nil {
@@ -68,6 +78,8 @@ func Create(ctx context.Context, parent *cobra.Command, parameterGroupService db
 
 	req_NameFlag = flags.NewStr(cmd, "name", "", "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
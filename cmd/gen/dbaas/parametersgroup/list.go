@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	dbaasSdk "github.com/MagaluCloud/mgc-sdk-go/dbaas"
@@ -56,11 +58,16 @@ func List(ctx context.Context, parent *cobra.Command, parameterGroupService dbaa
 				opts.Type = (*dbaasSdk.ParameterGroupType)(opts_TypeFlag.Value)
 			} // CobraFlagsAssign
 
-			parametergroupsresponse, err := parameterGroupService.List(ctx, opts)
+			parametergroupsresponse, streamed, err := cmdutils.ListAll(ctx, cmd, &opts.Offset, &opts.Limit, func(ctx context.Context) (any, error) {
+				return parameterGroupService.List(ctx, opts)
+			})
 
 			if err != nil {
 				return err
 			}
+			if streamed {
+				return nil
+			}
 
 			raw, _ := cmd.Root().PersistentFlags().GetBool("raw")
 			beautiful.NewOutput(raw).PrintData(parametergroupsresponse)
@@ -76,6 +83,9 @@ func List(ctx context.Context, parent *cobra.Command, parameterGroupService dbaa
 
 	opts_TypeFlag = flags.NewStr(cmd, "type", "", "") //CobraFlagsCreation
 
+	cmdutils.AddPaginationFlags(cmd) //CobraFlagsCreation
+	cmdutils.AddAllRegionsFlag(cmd)  //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -13,10 +13,10 @@ package parametersgroup
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	dbaasSdk "github.com/MagaluCloud/mgc-sdk-go/dbaas"
@@ -40,6 +40,10 @@ func Update(ctx context.Context, parent *cobra.Command, parameterGroupService db
 
 			req := dbaasSdk.ParameterGroupUpdateRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var ID string // ServiceSDKParamCreate
 
 			if len(args) > 0 {
@@ -48,7 +52,7 @@ func Update(ctx context.Context, parent *cobra.Command, parameterGroupService db
 			if IDFlag.IsChanged() {
 				ID = *IDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o id como argumento ou usar a flag --id")
+				return cmdutils.NewMissingArgError("id")
 			} // CobraFlagsAssign
 
 			if req_DescriptionFlag.IsChanged() {
@@ -59,6 +63,10 @@ func Update(ctx context.Context, parent *cobra.Command, parameterGroupService db
 				req.Name = req_NameFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "ParameterGroupService.Update", map[string]any{"ID": ID}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			parametergroupdetailresponse, err := parameterGroupService.Update(ctx, ID, req)
 
 			if err != nil {
@@ -77,6 +85,8 @@ func Update(ctx context.Context, parent *cobra.Command, parameterGroupService db
 
 	req_NameFlag = flags.NewStr(cmd, "name", "", "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	dbaasSdk "github.com/MagaluCloud/mgc-sdk-go/dbaas"
@@ -38,6 +40,10 @@ func Create(ctx context.Context, parent *cobra.Command, replicaService dbaasSdk.
 
 			req := dbaasSdk.ReplicaCreateRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			if req_InstanceTypeIDFlag.IsChanged() {
 				req.InstanceTypeID = req_InstanceTypeIDFlag.Value
 			} // CobraFlagsAssign
@@ -50,6 +56,10 @@ func Create(ctx context.Context, parent *cobra.Command, replicaService dbaasSdk.
 				req.SourceID = *req_SourceIDFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "ReplicaService.Create", nil, map[string]any{"req": req}) {
+				return nil
+			}
+
 			replicaresponse, err := replicaService.Create(ctx, req)
 
 			if err != nil {
@@ -68,6 +78,8 @@ func Create(ctx context.Context, parent *cobra.Command, replicaService dbaasSdk.
 
 	req_SourceIDFlag = flags.NewStr(cmd, "source-id", "", "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	dbaasSdk "github.com/MagaluCloud/mgc-sdk-go/dbaas"
@@ -50,11 +52,16 @@ func List(ctx context.Context, parent *cobra.Command, replicaService dbaasSdk.Re
 				opts.SourceID = opts_SourceIDFlag.Value
 			} // CobraFlagsAssign
 
-			replicasresponse, err := replicaService.List(ctx, opts)
+			replicasresponse, streamed, err := cmdutils.ListAll(ctx, cmd, &opts.Offset, &opts.Limit, func(ctx context.Context) (any, error) {
+				return replicaService.List(ctx, opts)
+			})
 
 			if err != nil {
 				return err
 			}
+			if streamed {
+				return nil
+			}
 
 			raw, _ := cmd.Root().PersistentFlags().GetBool("raw")
 			beautiful.NewOutput(raw).PrintData(replicasresponse)
@@ -68,6 +75,9 @@ func List(ctx context.Context, parent *cobra.Command, replicaService dbaasSdk.Re
 
 	opts_SourceIDFlag = flags.NewStr(cmd, "source-id", "", "") //CobraFlagsCreation
 
+	cmdutils.AddPaginationFlags(cmd) //CobraFlagsCreation
+	cmdutils.AddAllRegionsFlag(cmd)  //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
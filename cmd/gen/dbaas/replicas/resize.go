@@ -13,10 +13,10 @@ package replicas
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	dbaasSdk "github.com/MagaluCloud/mgc-sdk-go/dbaas"
@@ -38,9 +38,15 @@ func Resize(ctx context.Context, parent *cobra.Command, replicaService dbaasSdk.
 
 			req := dbaasSdk.ReplicaResizeRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var id string // ServiceSDKParamCreate
 
-			req.Volume = &dbaasSdk.InstanceVolumeResizeRequest{} //CobraStructInitialize
+			if req.Volume == nil {
+				req.Volume = &dbaasSdk.InstanceVolumeResizeRequest{}
+			} //CobraStructInitialize
 
 			if len(args) > 0 {
 				cmd.Flags().Set("id", args[0])
@@ -48,13 +54,17 @@ func Resize(ctx context.Context, parent *cobra.Command, replicaService dbaasSdk.
 			if idFlag.IsChanged() {
 				id = *idFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o id como argumento ou usar a flag --id")
+				return cmdutils.NewMissingArgError("id")
 			} // CobraFlagsAssign
 
 			if req_InstanceTypeIDFlag.IsChanged() {
 				req.InstanceTypeID = req_InstanceTypeIDFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "ReplicaService.Resize", map[string]any{"id": id}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			replicadetailresponse, err := replicaService.Resize(ctx, id, req)
 
 			if err != nil {
@@ -71,6 +81,8 @@ func Resize(ctx context.Context, parent *cobra.Command, replicaService dbaasSdk.
 
 	req_InstanceTypeIDFlag = flags.NewStr(cmd, "instance-type-id", "", "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -13,10 +13,10 @@ package replicas
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	dbaasSdk "github.com/MagaluCloud/mgc-sdk-go/dbaas"
@@ -42,9 +42,13 @@ func Stop(ctx context.Context, parent *cobra.Command, replicaService dbaasSdk.Re
 			if idFlag.IsChanged() {
 				id = *idFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o id como argumento ou usar a flag --id")
+				return cmdutils.NewMissingArgError("id")
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "ReplicaService.Stop", map[string]any{"id": id}, nil) {
+				return nil
+			}
+
 			replicadetailresponse, err := replicaService.Stop(ctx, id)
 
 			if err != nil {
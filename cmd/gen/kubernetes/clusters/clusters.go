@@ -33,5 +33,7 @@ func ClustersCmd(ctx context.Context, parent *cobra.Command, clusterService kube
 
 	Update(ctx, cmd, clusterService)
 
+	Wait(ctx, cmd, clusterService)
+
 	parent.AddCommand(cmd)
 }
@@ -17,6 +17,8 @@ import (
 
 	"github.com/spf13/cobra"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
 
 	kubernetesSdk "github.com/MagaluCloud/mgc-sdk-go/kubernetes"
@@ -48,6 +50,10 @@ func Create(ctx context.Context, parent *cobra.Command, clusterService kubernete
 
 			req := kubernetesSdk.ClusterRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			if req_AllowedCIDRsFlag.IsChanged() {
 				req.AllowedCIDRs = req_AllowedCIDRsFlag.Value
 			} // CobraFlagsAssign
@@ -80,12 +86,20 @@ func Create(ctx context.Context, parent *cobra.Command, clusterService kubernete
 				req.Version = req_VersionFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "ClusterService.Create", nil, map[string]any{"req": req}) {
+				return nil
+			}
+
 			createclusterresponse, err := clusterService.Create(ctx, req)
 
 			if err != nil {
 				return err
 			}
 
+			if err := waitCluster(ctx, cmd, clusterService, createclusterresponse.ID, "running"); err != nil {
+				return err
+			}
+
 			raw, _ := cmd.Root().PersistentFlags().GetBool("raw")
 			beautiful.NewOutput(raw).PrintData(createclusterresponse)
 			return nil
@@ -108,6 +122,10 @@ func Create(ctx context.Context, parent *cobra.Command, clusterService kubernete
 
 	req_VersionFlag = flags.NewStr(cmd, "version", "", "") //CobraFlagsCreation
 
+	cmdutils.AddWaitFlags(cmd) //CobraFlagsCreation
+
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
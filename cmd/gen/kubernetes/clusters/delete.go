@@ -13,9 +13,7 @@ package clusters
 import (
 	"context"
 
-	"fmt"
-
-	"github.com/charmbracelet/huh"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
 
 	"github.com/spf13/cobra"
 
@@ -42,12 +40,18 @@ func Delete(ctx context.Context, parent *cobra.Command, clusterService kubernete
 			if clusterIDFlag.IsChanged() {
 				clusterID = *clusterIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o cluster-id como argumento ou usar a flag --cluster-id")
+				return cmdutils.NewMissingArgError("cluster-id")
 			} // CobraFlagsAssign
 
-			var confirm bool
-			huh.NewConfirm().Title("This action cannot be undone. Proceed?").Affirmative("Yes").Negative("No").Value(&confirm).Run()
-			if !confirm {
+			if cmdutils.DryRun(cmd, "ClusterService.Delete", map[string]any{"clusterID": clusterID}, nil) {
+				return nil
+			}
+
+			confirmed, confirmErr := cmdutils.ConfirmByTyping(cmd, "The Kubernetes cluster and all of its data will be permanently deleted.", clusterID)
+			if confirmErr != nil {
+				return confirmErr
+			}
+			if !confirmed {
 				return nil
 			}
 
@@ -13,12 +13,12 @@ package clusters
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
 	"github.com/spf13/cobra"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
 
 	kubernetesSdk "github.com/MagaluCloud/mgc-sdk-go/kubernetes"
@@ -42,7 +42,7 @@ func GetKubeConfig(ctx context.Context, parent *cobra.Command, clusterService ku
 			if clusterIDFlag.IsChanged() {
 				clusterID = *clusterIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o cluster-id como argumento ou usar a flag --cluster-id")
+				return cmdutils.NewMissingArgError("cluster-id")
 			} // CobraFlagsAssign
 
 			kubeconfig, err := clusterService.GetKubeConfig(ctx, clusterID)
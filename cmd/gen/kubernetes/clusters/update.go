@@ -13,10 +13,10 @@ package clusters
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -38,6 +38,10 @@ func Update(ctx context.Context, parent *cobra.Command, clusterService kubernete
 
 			req := kubernetesSdk.PatchClusterRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var clusterID string // ServiceSDKParamCreate
 
 			if len(args) > 0 {
@@ -46,13 +50,17 @@ func Update(ctx context.Context, parent *cobra.Command, clusterService kubernete
 			if clusterIDFlag.IsChanged() {
 				clusterID = *clusterIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o cluster-id como argumento ou usar a flag --cluster-id")
+				return cmdutils.NewMissingArgError("cluster-id")
 			} // CobraFlagsAssign
 
 			if req_AllowedCIDRsFlag.IsChanged() {
 				req.AllowedCIDRs = req_AllowedCIDRsFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "ClusterService.Update", map[string]any{"clusterID": clusterID}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			patchclusterresponse, err := clusterService.Update(ctx, clusterID, req)
 
 			if err != nil {
@@ -69,6 +77,8 @@ func Update(ctx context.Context, parent *cobra.Command, clusterService kubernete
 
 	req_AllowedCIDRsFlag = flags.NewStrSlice(cmd, "allowed-cidrs", []string{}, "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
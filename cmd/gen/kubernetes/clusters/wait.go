@@ -0,0 +1,40 @@
+package clusters
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	kubernetesSdk "github.com/MagaluCloud/mgc-sdk-go/kubernetes"
+
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+)
+
+// clusterStatus retorna o state atual do cluster
+func clusterStatus(clusterService kubernetesSdk.ClusterService) func(ctx context.Context, id string) ([]string, error) {
+	return func(ctx context.Context, id string) ([]string, error) {
+		cluster, err := clusterService.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if cluster.Status == nil {
+			return []string{}, nil
+		}
+		return []string{cluster.Status.State}, nil
+	}
+}
+
+// waitCluster aguarda o cluster atingir um dos status quando --wait é usado
+func waitCluster(ctx context.Context, cmd *cobra.Command, clusterService kubernetesSdk.ClusterService, id string, target ...string) error {
+	if !cmdutils.ShouldWait(cmd) {
+		return nil
+	}
+	opts := cmdutils.NewWaitOptions(cmd, "cluster "+id, target...)
+	return cmdutils.WaitForStatus(ctx, opts, func(ctx context.Context) ([]string, error) {
+		return clusterStatus(clusterService)(ctx, id)
+	})
+}
+
+func Wait(ctx context.Context, parent *cobra.Command, clusterService kubernetesSdk.ClusterService) {
+	parent.AddCommand(cmdutils.NewWaitCmd(ctx, "cluster", "running", clusterStatus(clusterService)))
+}
@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -56,11 +58,16 @@ func List(ctx context.Context, parent *cobra.Command, flavorService kubernetesSd
 				opts.Sort = opts_SortFlag.Value
 			} // CobraFlagsAssign
 
-			flavorsavailable, err := flavorService.List(ctx, opts)
+			flavorsavailable, streamed, err := cmdutils.ListAll(ctx, cmd, &opts.Offset, &opts.Limit, func(ctx context.Context) (any, error) {
+				return flavorService.List(ctx, opts)
+			})
 
 			if err != nil {
 				return err
 			}
+			if streamed {
+				return nil
+			}
 
 			raw, _ := cmd.Root().PersistentFlags().GetBool("raw")
 			beautiful.NewOutput(raw).PrintData(flavorsavailable)
@@ -76,6 +83,9 @@ func List(ctx context.Context, parent *cobra.Command, flavorService kubernetesSd
 
 	opts_SortFlag = flags.NewStr(cmd, "sort", "", "") //CobraFlagsCreation
 
+	cmdutils.AddPaginationFlags(cmd) //CobraFlagsCreation
+	cmdutils.AddAllRegionsFlag(cmd)  //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -52,9 +54,15 @@ func Create(ctx context.Context, parent *cobra.Command, nodePoolService kubernet
 
 			req := kubernetesSdk.CreateNodePoolRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var clusterID string // ServiceSDKParamCreate
 
-			req.AutoScale = &kubernetesSdk.AutoScale{} //CobraStructInitialize
+			if req.AutoScale == nil {
+				req.AutoScale = &kubernetesSdk.AutoScale{}
+			} //CobraStructInitialize
 
 			if clusterIDFlag.IsChanged() {
 				clusterID = *clusterIDFlag.Value
@@ -96,6 +104,10 @@ func Create(ctx context.Context, parent *cobra.Command, nodePoolService kubernet
 				req.Taints = req_TaintsFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "NodePoolService.Create", map[string]any{"clusterID": clusterID}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			nodepool, err := nodePoolService.Create(ctx, clusterID, req)
 
 			if err != nil {
@@ -128,6 +140,8 @@ func Create(ctx context.Context, parent *cobra.Command, nodePoolService kubernet
 
 	req_TaintsFlag = flags.NewJSONArrayValue[kubernetesSdk.Taint](cmd, "taints", "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
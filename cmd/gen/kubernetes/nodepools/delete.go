@@ -13,9 +13,7 @@ package nodepools
 import (
 	"context"
 
-	"fmt"
-
-	"github.com/charmbracelet/huh"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
 
 	"github.com/spf13/cobra"
 
@@ -46,7 +44,7 @@ func Delete(ctx context.Context, parent *cobra.Command, nodePoolService kubernet
 			if clusterIDFlag.IsChanged() {
 				clusterID = *clusterIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o cluster-id como argumento ou usar a flag --cluster-id")
+				return cmdutils.NewMissingArgError("cluster-id")
 			} // CobraFlagsAssign
 
 			if len(args) > 1 {
@@ -55,12 +53,18 @@ func Delete(ctx context.Context, parent *cobra.Command, nodePoolService kubernet
 			if nodePoolIDFlag.IsChanged() {
 				nodePoolID = *nodePoolIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o node-pool-id como argumento ou usar a flag --node-pool-id")
+				return cmdutils.NewMissingArgError("node-pool-id")
 			} // CobraFlagsAssign
 
-			var confirm bool
-			huh.NewConfirm().Title("This action cannot be undone. Proceed?").Affirmative("Yes").Negative("No").Value(&confirm).Run()
-			if !confirm {
+			if cmdutils.DryRun(cmd, "NodePoolService.Delete", map[string]any{"clusterID": clusterID, "nodePoolID": nodePoolID}, nil) {
+				return nil
+			}
+
+			confirmed, confirmErr := cmdutils.Confirm(cmd, "This action cannot be undone. Proceed?")
+			if confirmErr != nil {
+				return confirmErr
+			}
+			if !confirmed {
 				return nil
 			}
 
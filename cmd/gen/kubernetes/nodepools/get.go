@@ -13,12 +13,12 @@ package nodepools
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
 	"github.com/spf13/cobra"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
 
 	kubernetesSdk "github.com/MagaluCloud/mgc-sdk-go/kubernetes"
@@ -46,7 +46,7 @@ func Get(ctx context.Context, parent *cobra.Command, nodePoolService kubernetesS
 			if clusterIDFlag.IsChanged() {
 				clusterID = *clusterIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o cluster-id como argumento ou usar a flag --cluster-id")
+				return cmdutils.NewMissingArgError("cluster-id")
 			} // CobraFlagsAssign
 
 			if len(args) > 1 {
@@ -55,7 +55,7 @@ func Get(ctx context.Context, parent *cobra.Command, nodePoolService kubernetesS
 			if nodePoolIDFlag.IsChanged() {
 				nodePoolID = *nodePoolIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o node-pool-id como argumento ou usar a flag --node-pool-id")
+				return cmdutils.NewMissingArgError("node-pool-id")
 			} // CobraFlagsAssign
 
 			nodepool, err := nodePoolService.Get(ctx, clusterID, nodePoolID)
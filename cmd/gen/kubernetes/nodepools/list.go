@@ -13,10 +13,10 @@ package nodepools
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -52,7 +52,7 @@ func List(ctx context.Context, parent *cobra.Command, nodePoolService kubernetes
 			if clusterIDFlag.IsChanged() {
 				clusterID = *clusterIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o cluster-id como argumento ou usar a flag --cluster-id")
+				return cmdutils.NewMissingArgError("cluster-id")
 			} // CobraFlagsAssign
 
 			if opts_ExpandFlag.IsChanged() {
@@ -71,11 +71,16 @@ func List(ctx context.Context, parent *cobra.Command, nodePoolService kubernetes
 				opts.Sort = opts_SortFlag.Value
 			} // CobraFlagsAssign
 
-			nodepool, err := nodePoolService.List(ctx, clusterID, opts)
+			nodepool, streamed, err := cmdutils.ListAll(ctx, cmd, &opts.Offset, &opts.Limit, func(ctx context.Context) (any, error) {
+				return nodePoolService.List(ctx, clusterID, opts)
+			})
 
 			if err != nil {
 				return err
 			}
+			if streamed {
+				return nil
+			}
 
 			raw, _ := cmd.Root().PersistentFlags().GetBool("raw")
 			beautiful.NewOutput(raw).PrintData(nodepool)
@@ -93,6 +98,8 @@ func List(ctx context.Context, parent *cobra.Command, nodePoolService kubernetes
 
 	opts_SortFlag = flags.NewStr(cmd, "sort", "", "") //CobraFlagsCreation
 
+	cmdutils.AddPaginationFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -13,10 +13,10 @@ package nodepools
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -40,11 +40,17 @@ func Update(ctx context.Context, parent *cobra.Command, nodePoolService kubernet
 
 			req := kubernetesSdk.PatchNodePoolRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var clusterID string // ServiceSDKParamCreate
 
 			var nodePoolID string // ServiceSDKParamCreate
 
-			req.AutoScale = &kubernetesSdk.AutoScale{} //CobraStructInitialize
+			if req.AutoScale == nil {
+				req.AutoScale = &kubernetesSdk.AutoScale{}
+			} //CobraStructInitialize
 
 			if len(args) > 0 {
 				cmd.Flags().Set("cluster-id", args[0])
@@ -52,7 +58,7 @@ func Update(ctx context.Context, parent *cobra.Command, nodePoolService kubernet
 			if clusterIDFlag.IsChanged() {
 				clusterID = *clusterIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o cluster-id como argumento ou usar a flag --cluster-id")
+				return cmdutils.NewMissingArgError("cluster-id")
 			} // CobraFlagsAssign
 
 			if len(args) > 1 {
@@ -61,13 +67,17 @@ func Update(ctx context.Context, parent *cobra.Command, nodePoolService kubernet
 			if nodePoolIDFlag.IsChanged() {
 				nodePoolID = *nodePoolIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o node-pool-id como argumento ou usar a flag --node-pool-id")
+				return cmdutils.NewMissingArgError("node-pool-id")
 			} // CobraFlagsAssign
 
 			if req_ReplicasFlag.IsChanged() {
 				req.Replicas = req_ReplicasFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "NodePoolService.Update", map[string]any{"clusterID": clusterID, "nodePoolID": nodePoolID}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			nodepool, err := nodePoolService.Update(ctx, clusterID, nodePoolID, req)
 
 			if err != nil {
@@ -86,6 +96,8 @@ func Update(ctx context.Context, parent *cobra.Command, nodePoolService kubernet
 
 	req_ReplicasFlag = flags.NewInt(cmd, "replicas", 0, "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	kubernetesSdk "github.com/MagaluCloud/mgc-sdk-go/kubernetes"
@@ -28,7 +30,9 @@ func List(ctx context.Context, parent *cobra.Command, versionService kubernetesS
 		Long:  `List all Kubernetes clusters`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 
-			version, err := versionService.List(ctx)
+			version, err := cmdutils.ListRegions(ctx, cmd, func(ctx context.Context) (any, error) {
+				return versionService.List(ctx)
+			})
 
 			if err != nil {
 				return err
@@ -40,6 +44,8 @@ func List(ctx context.Context, parent *cobra.Command, versionService kubernetesS
 		},
 	}
 
+	cmdutils.AddAllRegionsFlag(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -38,6 +40,10 @@ func Create(ctx context.Context, parent *cobra.Command, networkACLService lbaasS
 
 			req := lbaasSdk.CreateNetworkACLRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var lbID string // ServiceSDKParamCreate
 
 			if lbIDFlag.IsChanged() {
@@ -52,6 +58,10 @@ func Create(ctx context.Context, parent *cobra.Command, networkACLService lbaasS
 				req.RemoteIPPrefix = *req_RemoteIPPrefixFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "NetworkACLService.Create", map[string]any{"lbID": lbID}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			result, err := networkACLService.Create(ctx, lbID, req)
 
 			if err != nil {
@@ -70,6 +80,8 @@ func Create(ctx context.Context, parent *cobra.Command, networkACLService lbaasS
 
 	req_RemoteIPPrefixFlag = flags.NewStr(cmd, "remote-ipprefix", "", "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
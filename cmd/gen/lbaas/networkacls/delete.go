@@ -13,9 +13,7 @@ package networkacls
 import (
 	"context"
 
-	"fmt"
-
-	"github.com/charmbracelet/huh"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
 
 	"github.com/spf13/cobra"
 
@@ -46,7 +44,7 @@ func Delete(ctx context.Context, parent *cobra.Command, networkACLService lbaasS
 			if lbIDFlag.IsChanged() {
 				lbID = *lbIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o lb-id como argumento ou usar a flag --lb-id")
+				return cmdutils.NewMissingArgError("lb-id")
 			} // CobraFlagsAssign
 
 			if len(args) > 1 {
@@ -55,12 +53,18 @@ func Delete(ctx context.Context, parent *cobra.Command, networkACLService lbaasS
 			if aclIDFlag.IsChanged() {
 				aclID = *aclIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o acl-id como argumento ou usar a flag --acl-id")
+				return cmdutils.NewMissingArgError("acl-id")
 			} // CobraFlagsAssign
 
-			var confirm bool
-			huh.NewConfirm().Title("This action cannot be undone. Proceed?").Affirmative("Yes").Negative("No").Value(&confirm).Run()
-			if !confirm {
+			if cmdutils.DryRun(cmd, "NetworkACLService.Delete", map[string]any{"lbID": lbID, "aclID": aclID}, nil) {
+				return nil
+			}
+
+			confirmed, confirmErr := cmdutils.Confirm(cmd, "This action cannot be undone. Proceed?")
+			if confirmErr != nil {
+				return confirmErr
+			}
+			if !confirmed {
 				return nil
 			}
 
@@ -13,7 +13,7 @@ package networkacls
 import (
 	"context"
 
-	"fmt"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
 
 	"github.com/spf13/cobra"
 
@@ -36,6 +36,10 @@ func Replace(ctx context.Context, parent *cobra.Command, networkACLService lbaas
 
 			req := lbaasSdk.UpdateNetworkACLRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var lbID string // ServiceSDKParamCreate
 
 			if len(args) > 0 {
@@ -44,13 +48,17 @@ func Replace(ctx context.Context, parent *cobra.Command, networkACLService lbaas
 			if lbIDFlag.IsChanged() {
 				lbID = *lbIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o lb-id como argumento ou usar a flag --lb-id")
+				return cmdutils.NewMissingArgError("lb-id")
 			} // CobraFlagsAssign
 
 			if req_AclsFlag.IsChanged() {
 				req.Acls = *req_AclsFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "NetworkACLService.Replace", map[string]any{"lbID": lbID}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			err := networkACLService.Replace(ctx, lbID, req)
 
 			if err != nil {
@@ -65,6 +73,8 @@ func Replace(ctx context.Context, parent *cobra.Command, networkACLService lbaas
 
 	req_AclsFlag = flags.NewJSONArrayValue[lbaasSdk.CreateNetworkACLRequest](cmd, "acls", "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -48,6 +50,10 @@ func Create(ctx context.Context, parent *cobra.Command, networkBackendService lb
 
 			req := lbaasSdk.CreateBackendRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var lbID string // ServiceSDKParamCreate
 
 			if lbIDFlag.IsChanged() {
@@ -82,6 +88,10 @@ func Create(ctx context.Context, parent *cobra.Command, networkBackendService lb
 				req.Targets = req_TargetsFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "NetworkBackendService.Create", map[string]any{"lbID": lbID}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			result, err := networkBackendService.Create(ctx, lbID, req)
 
 			if err != nil {
@@ -110,6 +120,8 @@ func Create(ctx context.Context, parent *cobra.Command, networkBackendService lb
 
 	req_TargetsFlag = flags.NewJSONArrayValue[lbaasSdk.NetworkBackendInstanceTargetRequest](cmd, "targets", "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
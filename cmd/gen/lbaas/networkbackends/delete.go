@@ -13,9 +13,7 @@ package networkbackends
 import (
 	"context"
 
-	"fmt"
-
-	"github.com/charmbracelet/huh"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
 
 	"github.com/spf13/cobra"
 
@@ -46,7 +44,7 @@ func Delete(ctx context.Context, parent *cobra.Command, networkBackendService lb
 			if lbIDFlag.IsChanged() {
 				lbID = *lbIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o lb-id como argumento ou usar a flag --lb-id")
+				return cmdutils.NewMissingArgError("lb-id")
 			} // CobraFlagsAssign
 
 			if len(args) > 1 {
@@ -55,12 +53,18 @@ func Delete(ctx context.Context, parent *cobra.Command, networkBackendService lb
 			if backendIDFlag.IsChanged() {
 				backendID = *backendIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o backend-id como argumento ou usar a flag --backend-id")
+				return cmdutils.NewMissingArgError("backend-id")
 			} // CobraFlagsAssign
 
-			var confirm bool
-			huh.NewConfirm().Title("This action cannot be undone. Proceed?").Affirmative("Yes").Negative("No").Value(&confirm).Run()
-			if !confirm {
+			if cmdutils.DryRun(cmd, "NetworkBackendService.Delete", map[string]any{"lbID": lbID, "backendID": backendID}, nil) {
+				return nil
+			}
+
+			confirmed, confirmErr := cmdutils.Confirm(cmd, "This action cannot be undone. Proceed?")
+			if confirmErr != nil {
+				return confirmErr
+			}
+			if !confirmed {
 				return nil
 			}
 
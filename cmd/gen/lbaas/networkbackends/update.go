@@ -13,10 +13,10 @@ package networkbackends
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -44,6 +44,10 @@ func Update(ctx context.Context, parent *cobra.Command, networkBackendService lb
 
 			req := lbaasSdk.UpdateNetworkBackendRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var backendID string // ServiceSDKParamCreate
 
 			var lbID string // ServiceSDKParamCreate
@@ -54,7 +58,7 @@ func Update(ctx context.Context, parent *cobra.Command, networkBackendService lb
 			if lbIDFlag.IsChanged() {
 				lbID = *lbIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o lb-id como argumento ou usar a flag --lb-id")
+				return cmdutils.NewMissingArgError("lb-id")
 			} // CobraFlagsAssign
 
 			if len(args) > 1 {
@@ -63,7 +67,7 @@ func Update(ctx context.Context, parent *cobra.Command, networkBackendService lb
 			if backendIDFlag.IsChanged() {
 				backendID = *backendIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o backend-id como argumento ou usar a flag --backend-id")
+				return cmdutils.NewMissingArgError("backend-id")
 			} // CobraFlagsAssign
 
 			if req_CloseConnectionsOnHostHealthFailureFlag.IsChanged() {
@@ -78,6 +82,10 @@ func Update(ctx context.Context, parent *cobra.Command, networkBackendService lb
 				req.PanicThreshold = req_PanicThresholdFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "NetworkBackendService.Update", map[string]any{"lbID": lbID, "backendID": backendID}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			result, err := networkBackendService.Update(ctx, lbID, backendID, req)
 
 			if err != nil {
@@ -100,6 +108,8 @@ func Update(ctx context.Context, parent *cobra.Command, networkBackendService lb
 
 	req_PanicThresholdFlag = flags.NewFloat64(cmd, "panic-threshold", 0, "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -40,6 +42,10 @@ func Create(ctx context.Context, parent *cobra.Command, networkBackendTargetServ
 
 			req := lbaasSdk.CreateNetworkBackendTargetRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var backendID string // ServiceSDKParamCreate
 
 			var lbID string // ServiceSDKParamCreate
@@ -60,6 +66,10 @@ func Create(ctx context.Context, parent *cobra.Command, networkBackendTargetServ
 				req.Targets = *req_TargetsFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "NetworkBackendTargetService.Create", map[string]any{"lbID": lbID, "backendID": backendID}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			result, err := networkBackendTargetService.Create(ctx, lbID, backendID, req)
 
 			if err != nil {
@@ -80,6 +90,8 @@ func Create(ctx context.Context, parent *cobra.Command, networkBackendTargetServ
 
 	req_TargetsFlag = flags.NewJSONArrayValue[lbaasSdk.NetworkBackendInstanceTargetRequest](cmd, "targets", "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -13,9 +13,7 @@ package networkbackendtargets
 import (
 	"context"
 
-	"fmt"
-
-	"github.com/charmbracelet/huh"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
 
 	"github.com/spf13/cobra"
 
@@ -50,7 +48,7 @@ func Delete(ctx context.Context, parent *cobra.Command, networkBackendTargetServ
 			if lbIDFlag.IsChanged() {
 				lbID = *lbIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o lb-id como argumento ou usar a flag --lb-id")
+				return cmdutils.NewMissingArgError("lb-id")
 			} // CobraFlagsAssign
 
 			if len(args) > 1 {
@@ -59,7 +57,7 @@ func Delete(ctx context.Context, parent *cobra.Command, networkBackendTargetServ
 			if backendIDFlag.IsChanged() {
 				backendID = *backendIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o backend-id como argumento ou usar a flag --backend-id")
+				return cmdutils.NewMissingArgError("backend-id")
 			} // CobraFlagsAssign
 
 			if len(args) > 2 {
@@ -68,12 +66,18 @@ func Delete(ctx context.Context, parent *cobra.Command, networkBackendTargetServ
 			if targetIDFlag.IsChanged() {
 				targetID = *targetIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o target-id como argumento ou usar a flag --target-id")
+				return cmdutils.NewMissingArgError("target-id")
 			} // CobraFlagsAssign
 
-			var confirm bool
-			huh.NewConfirm().Title("This action cannot be undone. Proceed?").Affirmative("Yes").Negative("No").Value(&confirm).Run()
-			if !confirm {
+			if cmdutils.DryRun(cmd, "NetworkBackendTargetService.Delete", map[string]any{"lbID": lbID, "backendID": backendID, "targetID": targetID}, nil) {
+				return nil
+			}
+
+			confirmed, confirmErr := cmdutils.Confirm(cmd, "This action cannot be undone. Proceed?")
+			if confirmErr != nil {
+				return confirmErr
+			}
+			if !confirmed {
 				return nil
 			}
 
@@ -13,10 +13,10 @@ package networkbackendtargets
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -42,6 +42,10 @@ func Replace(ctx context.Context, parent *cobra.Command, networkBackendTargetSer
 
 			req := lbaasSdk.CreateNetworkBackendTargetRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var backendID string // ServiceSDKParamCreate
 
 			var lbID string // ServiceSDKParamCreate
@@ -52,7 +56,7 @@ func Replace(ctx context.Context, parent *cobra.Command, networkBackendTargetSer
 			if lbIDFlag.IsChanged() {
 				lbID = *lbIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o lb-id como argumento ou usar a flag --lb-id")
+				return cmdutils.NewMissingArgError("lb-id")
 			} // CobraFlagsAssign
 
 			if len(args) > 1 {
@@ -61,7 +65,7 @@ func Replace(ctx context.Context, parent *cobra.Command, networkBackendTargetSer
 			if backendIDFlag.IsChanged() {
 				backendID = *backendIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o backend-id como argumento ou usar a flag --backend-id")
+				return cmdutils.NewMissingArgError("backend-id")
 			} // CobraFlagsAssign
 
 			if req_HealthCheckIDFlag.IsChanged() {
@@ -72,6 +76,10 @@ func Replace(ctx context.Context, parent *cobra.Command, networkBackendTargetSer
 				req.Targets = *req_TargetsFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "NetworkBackendTargetService.Replace", map[string]any{"lbID": lbID, "backendID": backendID}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			result, err := networkBackendTargetService.Replace(ctx, lbID, backendID, req)
 
 			if err != nil {
@@ -92,6 +100,8 @@ func Replace(ctx context.Context, parent *cobra.Command, networkBackendTargetSer
 
 	req_TargetsFlag = flags.NewJSONArrayValue[lbaasSdk.NetworkBackendInstanceTargetRequest](cmd, "targets", "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -42,6 +44,10 @@ func Create(ctx context.Context, parent *cobra.Command, networkCertificateServic
 
 			req := lbaasSdk.CreateNetworkCertificateRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var lbID string // ServiceSDKParamCreate
 
 			if lbIDFlag.IsChanged() {
@@ -64,6 +70,10 @@ func Create(ctx context.Context, parent *cobra.Command, networkCertificateServic
 				req.PrivateKey = *req_PrivateKeyFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "NetworkCertificateService.Create", map[string]any{"lbID": lbID}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			networktlscertificateresponse, err := networkCertificateService.Create(ctx, lbID, req)
 
 			if err != nil {
@@ -86,6 +96,8 @@ func Create(ctx context.Context, parent *cobra.Command, networkCertificateServic
 
 	req_PrivateKeyFlag = flags.NewStr(cmd, "private-key", "", "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -13,12 +13,12 @@ package networkcertificates
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
 	"github.com/spf13/cobra"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
 
 	lbaasSdk "github.com/MagaluCloud/mgc-sdk-go/lbaas"
@@ -46,7 +46,7 @@ func Get(ctx context.Context, parent *cobra.Command, networkCertificateService l
 			if lbIDFlag.IsChanged() {
 				lbID = *lbIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o lb-id como argumento ou usar a flag --lb-id")
+				return cmdutils.NewMissingArgError("lb-id")
 			} // CobraFlagsAssign
 
 			if len(args) > 1 {
@@ -55,7 +55,7 @@ func Get(ctx context.Context, parent *cobra.Command, networkCertificateService l
 			if certicateIDFlag.IsChanged() {
 				certicateID = *certicateIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o certicate-id como argumento ou usar a flag --certicate-id")
+				return cmdutils.NewMissingArgError("certicate-id")
 			} // CobraFlagsAssign
 
 			networktlscertificateresponse, err := networkCertificateService.Get(ctx, lbID, certicateID)
@@ -13,7 +13,7 @@ package networkcertificates
 import (
 	"context"
 
-	"fmt"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
 
 	"github.com/spf13/cobra"
 
@@ -40,6 +40,10 @@ func Update(ctx context.Context, parent *cobra.Command, networkCertificateServic
 
 			req := lbaasSdk.UpdateNetworkCertificateRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var certicateID string // ServiceSDKParamCreate
 
 			var lbID string // ServiceSDKParamCreate
@@ -50,7 +54,7 @@ func Update(ctx context.Context, parent *cobra.Command, networkCertificateServic
 			if lbIDFlag.IsChanged() {
 				lbID = *lbIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o lb-id como argumento ou usar a flag --lb-id")
+				return cmdutils.NewMissingArgError("lb-id")
 			} // CobraFlagsAssign
 
 			if len(args) > 1 {
@@ -59,7 +63,7 @@ func Update(ctx context.Context, parent *cobra.Command, networkCertificateServic
 			if certicateIDFlag.IsChanged() {
 				certicateID = *certicateIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o certicate-id como argumento ou usar a flag --certicate-id")
+				return cmdutils.NewMissingArgError("certicate-id")
 			} // CobraFlagsAssign
 
 			if req_CertificateFlag.IsChanged() {
@@ -70,6 +74,10 @@ func Update(ctx context.Context, parent *cobra.Command, networkCertificateServic
 				req.PrivateKey = *req_PrivateKeyFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "NetworkCertificateService.Update", map[string]any{"lbID": lbID, "certicateID": certicateID}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			err := networkCertificateService.Update(ctx, lbID, certicateID, req)
 
 			if err != nil {
@@ -88,6 +96,8 @@ func Update(ctx context.Context, parent *cobra.Command, networkCertificateServic
 
 	req_PrivateKeyFlag = flags.NewStr(cmd, "private-key", "", "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -54,6 +56,10 @@ func Create(ctx context.Context, parent *cobra.Command, networkHealthCheckServic
 
 			req := lbaasSdk.CreateNetworkHealthCheckRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var lbID string // ServiceSDKParamCreate
 
 			if lbIDFlag.IsChanged() {
@@ -100,6 +106,10 @@ func Create(ctx context.Context, parent *cobra.Command, networkHealthCheckServic
 				req.UnhealthyThresholdCount = req_UnhealthyThresholdCountFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "NetworkHealthCheckService.Create", map[string]any{"lbID": lbID}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			networkhealthcheckresponse, err := networkHealthCheckService.Create(ctx, lbID, req)
 
 			if err != nil {
@@ -134,6 +144,8 @@ func Create(ctx context.Context, parent *cobra.Command, networkHealthCheckServic
 
 	req_UnhealthyThresholdCountFlag = flags.NewInt(cmd, "unhealthy-threshold-count", 0, "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
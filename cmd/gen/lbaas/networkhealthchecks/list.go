@@ -13,10 +13,10 @@ package networkhealthchecks
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -50,7 +50,7 @@ func List(ctx context.Context, parent *cobra.Command, networkHealthCheckService
 			if lbIDFlag.IsChanged() {
 				lbID = *lbIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o lb-id como argumento ou usar a flag --lb-id")
+				return cmdutils.NewMissingArgError("lb-id")
 			} // CobraFlagsAssign
 
 			if options_LimitFlag.IsChanged() {
@@ -65,11 +65,16 @@ func List(ctx context.Context, parent *cobra.Command, networkHealthCheckService
 				options.Sort = options_SortFlag.Value
 			} // CobraFlagsAssign
 
-			networkpaginatedhealthcheckresponse, err := networkHealthCheckService.List(ctx, lbID, options)
+			networkpaginatedhealthcheckresponse, streamed, err := cmdutils.ListAll(ctx, cmd, &options.Offset, &options.Limit, func(ctx context.Context) (any, error) {
+				return networkHealthCheckService.List(ctx, lbID, options)
+			})
 
 			if err != nil {
 				return err
 			}
+			if streamed {
+				return nil
+			}
 
 			raw, _ := cmd.Root().PersistentFlags().GetBool("raw")
 			beautiful.NewOutput(raw).PrintData(networkpaginatedhealthcheckresponse)
@@ -85,6 +90,8 @@ func List(ctx context.Context, parent *cobra.Command, networkHealthCheckService
 
 	options_SortFlag = flags.NewStr(cmd, "sort", "", "") //CobraFlagsCreation
 
+	cmdutils.AddPaginationFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
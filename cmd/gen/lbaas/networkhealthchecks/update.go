@@ -13,7 +13,7 @@ package networkhealthchecks
 import (
 	"context"
 
-	"fmt"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
 
 	"github.com/spf13/cobra"
 
@@ -52,6 +52,10 @@ func Update(ctx context.Context, parent *cobra.Command, networkHealthCheckServic
 
 			req := lbaasSdk.UpdateNetworkHealthCheckRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var healthCheckID string // ServiceSDKParamCreate
 
 			var lbID string // ServiceSDKParamCreate
@@ -62,7 +66,7 @@ func Update(ctx context.Context, parent *cobra.Command, networkHealthCheckServic
 			if lbIDFlag.IsChanged() {
 				lbID = *lbIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o lb-id como argumento ou usar a flag --lb-id")
+				return cmdutils.NewMissingArgError("lb-id")
 			} // CobraFlagsAssign
 
 			if len(args) > 1 {
@@ -71,7 +75,7 @@ func Update(ctx context.Context, parent *cobra.Command, networkHealthCheckServic
 			if healthCheckIDFlag.IsChanged() {
 				healthCheckID = *healthCheckIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o health-check-id como argumento ou usar a flag --health-check-id")
+				return cmdutils.NewMissingArgError("health-check-id")
 			} // CobraFlagsAssign
 
 			if req_HealthyStatusCodeFlag.IsChanged() {
@@ -106,6 +110,10 @@ func Update(ctx context.Context, parent *cobra.Command, networkHealthCheckServic
 				req.UnhealthyThresholdCount = req_UnhealthyThresholdCountFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "NetworkHealthCheckService.Update", map[string]any{"lbID": lbID, "healthCheckID": healthCheckID}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			err := networkHealthCheckService.Update(ctx, lbID, healthCheckID, req)
 
 			if err != nil {
@@ -136,6 +144,8 @@ func Update(ctx context.Context, parent *cobra.Command, networkHealthCheckServic
 
 	req_UnhealthyThresholdCountFlag = flags.NewInt(cmd, "unhealthy-threshold-count", 0, "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -44,6 +46,10 @@ func Create(ctx context.Context, parent *cobra.Command, networkListenerService l
 
 			req := lbaasSdk.CreateNetworkListenerRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var backendID string // ServiceSDKParamCreate
 
 			var lbID string // ServiceSDKParamCreate
@@ -72,6 +78,10 @@ func Create(ctx context.Context, parent *cobra.Command, networkListenerService l
 				req.TLSCertificateID = req_TLSCertificateIDFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "NetworkListenerService.Create", map[string]any{"lbID": lbID, "backendID": backendID}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			networklistenerresponse, err := networkListenerService.Create(ctx, lbID, backendID, req)
 
 			if err != nil {
@@ -96,6 +106,8 @@ func Create(ctx context.Context, parent *cobra.Command, networkListenerService l
 
 	req_TLSCertificateIDFlag = flags.NewStr(cmd, "tlscertificate-id", "", "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
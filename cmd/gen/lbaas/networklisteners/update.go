@@ -13,7 +13,7 @@ package networklisteners
 import (
 	"context"
 
-	"fmt"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
 
 	"github.com/spf13/cobra"
 
@@ -40,6 +40,10 @@ func Update(ctx context.Context, parent *cobra.Command, networkListenerService l
 
 			req := lbaasSdk.UpdateNetworkListenerRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var lbID string // ServiceSDKParamCreate
 
 			var listenerID string // ServiceSDKParamCreate
@@ -50,7 +54,7 @@ func Update(ctx context.Context, parent *cobra.Command, networkListenerService l
 			if lbIDFlag.IsChanged() {
 				lbID = *lbIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o lb-id como argumento ou usar a flag --lb-id")
+				return cmdutils.NewMissingArgError("lb-id")
 			} // CobraFlagsAssign
 
 			if len(args) > 1 {
@@ -59,7 +63,7 @@ func Update(ctx context.Context, parent *cobra.Command, networkListenerService l
 			if listenerIDFlag.IsChanged() {
 				listenerID = *listenerIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o listener-id como argumento ou usar a flag --listener-id")
+				return cmdutils.NewMissingArgError("listener-id")
 			} // CobraFlagsAssign
 
 			if req_NameFlag.IsChanged() {
@@ -70,6 +74,10 @@ func Update(ctx context.Context, parent *cobra.Command, networkListenerService l
 				req.TLSCertificateID = req_TLSCertificateIDFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "NetworkListenerService.Update", map[string]any{"lbID": lbID, "listenerID": listenerID}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			err := networkListenerService.Update(ctx, lbID, listenerID, req)
 
 			if err != nil {
@@ -88,6 +96,8 @@ func Update(ctx context.Context, parent *cobra.Command, networkListenerService l
 
 	req_TLSCertificateIDFlag = flags.NewStr(cmd, "tlscertificate-id", "", "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -54,6 +56,10 @@ func Create(ctx context.Context, parent *cobra.Command, networkLoadBalancerServi
 
 			create := lbaasSdk.CreateNetworkLoadBalancerRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &create); done || err != nil {
+				return err
+			}
+
 			if create_ACLsFlag.IsChanged() {
 				create.ACLs = *create_ACLsFlag.Value
 			} // CobraFlagsAssign
@@ -98,6 +104,10 @@ func Create(ctx context.Context, parent *cobra.Command, networkLoadBalancerServi
 				create.VPCID = *create_VPCIDFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "NetworkLoadBalancerService.Create", nil, map[string]any{"create": create}) {
+				return nil
+			}
+
 			result, err := networkLoadBalancerService.Create(ctx, create)
 
 			if err != nil {
@@ -132,6 +142,8 @@ func Create(ctx context.Context, parent *cobra.Command, networkLoadBalancerServi
 
 	create_VPCIDFlag = flags.NewStr(cmd, "vpcid", "", "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -13,12 +13,12 @@ package networkloadbalancers
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
 	"github.com/spf13/cobra"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
 
 	lbaasSdk "github.com/MagaluCloud/mgc-sdk-go/lbaas"
@@ -42,7 +42,7 @@ func Get(ctx context.Context, parent *cobra.Command, networkLoadBalancerService
 			if idFlag.IsChanged() {
 				id = *idFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o id como argumento ou usar a flag --id")
+				return cmdutils.NewMissingArgError("id")
 			} // CobraFlagsAssign
 
 			networkloadbalancerresponse, err := networkLoadBalancerService.Get(ctx, id)
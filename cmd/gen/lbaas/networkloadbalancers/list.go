@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -50,11 +52,16 @@ func List(ctx context.Context, parent *cobra.Command, networkLoadBalancerService
 				options.Sort = options_SortFlag.Value
 			} // CobraFlagsAssign
 
-			networklbpaginatedresponse, err := networkLoadBalancerService.List(ctx, options)
+			networklbpaginatedresponse, streamed, err := cmdutils.ListAll(ctx, cmd, &options.Offset, &options.Limit, func(ctx context.Context) (any, error) {
+				return networkLoadBalancerService.List(ctx, options)
+			})
 
 			if err != nil {
 				return err
 			}
+			if streamed {
+				return nil
+			}
 
 			raw, _ := cmd.Root().PersistentFlags().GetBool("raw")
 			beautiful.NewOutput(raw).PrintData(networklbpaginatedresponse)
@@ -68,6 +75,9 @@ func List(ctx context.Context, parent *cobra.Command, networkLoadBalancerService
 
 	options_SortFlag = flags.NewStr(cmd, "sort", "", "") //CobraFlagsCreation
 
+	cmdutils.AddPaginationFlags(cmd) //CobraFlagsCreation
+	cmdutils.AddAllRegionsFlag(cmd)  //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -13,10 +13,10 @@ package networkloadbalancers
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -40,6 +40,10 @@ func Update(ctx context.Context, parent *cobra.Command, networkLoadBalancerServi
 
 			loadBalancer := lbaasSdk.UpdateNetworkLoadBalancerRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &loadBalancer); done || err != nil {
+				return err
+			}
+
 			var id string // ServiceSDKParamCreate
 
 			if len(args) > 0 {
@@ -48,7 +52,7 @@ func Update(ctx context.Context, parent *cobra.Command, networkLoadBalancerServi
 			if idFlag.IsChanged() {
 				id = *idFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o id como argumento ou usar a flag --id")
+				return cmdutils.NewMissingArgError("id")
 			} // CobraFlagsAssign
 
 			if loadBalancer_DescriptionFlag.IsChanged() {
@@ -59,6 +63,10 @@ func Update(ctx context.Context, parent *cobra.Command, networkLoadBalancerServi
 				loadBalancer.Name = loadBalancer_NameFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "NetworkLoadBalancerService.Update", map[string]any{"id": id}, map[string]any{"loadBalancer": loadBalancer}) {
+				return nil
+			}
+
 			result, err := networkLoadBalancerService.Update(ctx, id, loadBalancer)
 
 			if err != nil {
@@ -77,6 +85,8 @@ func Update(ctx context.Context, parent *cobra.Command, networkLoadBalancerServi
 
 	loadBalancer_NameFlag = flags.NewStr(cmd, "name", "", "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
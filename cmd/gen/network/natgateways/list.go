@@ -13,10 +13,10 @@ package natgateways
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -50,7 +50,7 @@ func List(ctx context.Context, parent *cobra.Command, natGatewayService networkS
 			if vpcIDFlag.IsChanged() {
 				vpcID = *vpcIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o vpc-id como argumento ou usar a flag --vpc-id")
+				return cmdutils.NewMissingArgError("vpc-id")
 			} // CobraFlagsAssign
 
 			if opts_LimitFlag.IsChanged() {
@@ -65,11 +65,16 @@ func List(ctx context.Context, parent *cobra.Command, natGatewayService networkS
 				opts.Sort = opts_SortFlag.Value
 			} // CobraFlagsAssign
 
-			natgatewayresponse, err := natGatewayService.List(ctx, vpcID, opts)
+			natgatewayresponse, streamed, err := cmdutils.ListAll(ctx, cmd, &opts.Offset, &opts.Limit, func(ctx context.Context) (any, error) {
+				return natGatewayService.List(ctx, vpcID, opts)
+			})
 
 			if err != nil {
 				return err
 			}
+			if streamed {
+				return nil
+			}
 
 			raw, _ := cmd.Root().PersistentFlags().GetBool("raw")
 			beautiful.NewOutput(raw).PrintData(natgatewayresponse)
@@ -85,6 +90,8 @@ func List(ctx context.Context, parent *cobra.Command, natGatewayService networkS
 
 	vpcIDFlag = flags.NewStr(cmd, "vpc-id", "", " (required)") //CobraFlagsCreation
 
+	cmdutils.AddPaginationFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
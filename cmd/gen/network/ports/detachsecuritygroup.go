@@ -13,7 +13,7 @@ package ports
 import (
 	"context"
 
-	"fmt"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
 
 	"github.com/spf13/cobra"
 
@@ -44,7 +44,7 @@ func DetachSecurityGroup(ctx context.Context, parent *cobra.Command, portService
 			if portIDFlag.IsChanged() {
 				portID = *portIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o port-id como argumento ou usar a flag --port-id")
+				return cmdutils.NewMissingArgError("port-id")
 			} // CobraFlagsAssign
 
 			if len(args) > 1 {
@@ -53,9 +53,13 @@ func DetachSecurityGroup(ctx context.Context, parent *cobra.Command, portService
 			if securityGroupIDFlag.IsChanged() {
 				securityGroupID = *securityGroupIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o security-group-id como argumento ou usar a flag --security-group-id")
+				return cmdutils.NewMissingArgError("security-group-id")
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "PortService.DetachSecurityGroup", map[string]any{"portID": portID, "securityGroupID": securityGroupID}, nil) {
+				return nil
+			}
+
 			err := portService.DetachSecurityGroup(ctx, portID, securityGroupID)
 
 			if err != nil {
@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	networkSdk "github.com/MagaluCloud/mgc-sdk-go/network"
@@ -28,7 +30,9 @@ func List(ctx context.Context, parent *cobra.Command, portService networkSdk.Por
 		Long:  `List all VPCs`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 
-			portresponse, err := portService.List(ctx)
+			portresponse, err := cmdutils.ListRegions(ctx, cmd, func(ctx context.Context) (any, error) {
+				return portService.List(ctx)
+			})
 
 			if err != nil {
 				return err
@@ -40,6 +44,8 @@ func List(ctx context.Context, parent *cobra.Command, portService networkSdk.Por
 		},
 	}
 
+	cmdutils.AddAllRegionsFlag(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -13,7 +13,7 @@ package ports
 import (
 	"context"
 
-	"fmt"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
 
 	"github.com/spf13/cobra"
 
@@ -36,6 +36,10 @@ func Update(ctx context.Context, parent *cobra.Command, portService networkSdk.P
 
 			req := networkSdk.PortUpdateRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var id string // ServiceSDKParamCreate
 
 			if len(args) > 0 {
@@ -44,13 +48,17 @@ func Update(ctx context.Context, parent *cobra.Command, portService networkSdk.P
 			if idFlag.IsChanged() {
 				id = *idFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o id como argumento ou usar a flag --id")
+				return cmdutils.NewMissingArgError("id")
 			} // CobraFlagsAssign
 
 			if req_IPSpoofingGuardFlag.IsChanged() {
 				req.IPSpoofingGuard = req_IPSpoofingGuardFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "PortService.Update", map[string]any{"id": id}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			err := portService.Update(ctx, id, req)
 
 			if err != nil {
@@ -65,6 +73,8 @@ func Update(ctx context.Context, parent *cobra.Command, portService networkSdk.P
 
 	req_IPSpoofingGuardFlag = flags.NewBool(cmd, "ipspoofing-guard", false, "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
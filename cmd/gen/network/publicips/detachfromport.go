@@ -13,7 +13,7 @@ package publicips
 import (
 	"context"
 
-	"fmt"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
 
 	"github.com/spf13/cobra"
 
@@ -44,7 +44,7 @@ func DetachFromPort(ctx context.Context, parent *cobra.Command, publicIPService
 			if publicIPIDFlag.IsChanged() {
 				publicIPID = *publicIPIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o public-ipid como argumento ou usar a flag --public-ipid")
+				return cmdutils.NewMissingArgError("public-ipid")
 			} // CobraFlagsAssign
 
 			if len(args) > 1 {
@@ -53,9 +53,13 @@ func DetachFromPort(ctx context.Context, parent *cobra.Command, publicIPService
 			if portIDFlag.IsChanged() {
 				portID = *portIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o port-id como argumento ou usar a flag --port-id")
+				return cmdutils.NewMissingArgError("port-id")
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "PublicIPService.DetachFromPort", map[string]any{"publicIPID": publicIPID, "portID": portID}, nil) {
+				return nil
+			}
+
 			err := publicIPService.DetachFromPort(ctx, publicIPID, portID)
 
 			if err != nil {
@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -48,6 +50,10 @@ func Create(ctx context.Context, parent *cobra.Command, ruleService networkSdk.R
 
 			req := networkSdk.RuleCreateRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var securityGroupID string // ServiceSDKParamCreate
 
 			if req_DescriptionFlag.IsChanged() {
@@ -82,6 +88,10 @@ func Create(ctx context.Context, parent *cobra.Command, ruleService networkSdk.R
 				securityGroupID = *securityGroupIDFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "RuleService.Create", map[string]any{"securityGroupID": securityGroupID}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			result, err := ruleService.Create(ctx, securityGroupID, req)
 
 			if err != nil {
@@ -110,6 +120,8 @@ func Create(ctx context.Context, parent *cobra.Command, ruleService networkSdk.R
 
 	securityGroupIDFlag = flags.NewStr(cmd, "security-group-id", "", "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
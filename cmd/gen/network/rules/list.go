@@ -13,12 +13,12 @@ package rules
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
 	"github.com/spf13/cobra"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
 
 	networkSdk "github.com/MagaluCloud/mgc-sdk-go/network"
@@ -42,7 +42,7 @@ func List(ctx context.Context, parent *cobra.Command, ruleService networkSdk.Rul
 			if securityGroupIDFlag.IsChanged() {
 				securityGroupID = *securityGroupIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o security-group-id como argumento ou usar a flag --security-group-id")
+				return cmdutils.NewMissingArgError("security-group-id")
 			} // CobraFlagsAssign
 
 			ruleresponse, err := ruleService.List(ctx, securityGroupID)
@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -38,6 +40,10 @@ func Create(ctx context.Context, parent *cobra.Command, securityGroupService net
 
 			req := networkSdk.SecurityGroupCreateRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			if req_DescriptionFlag.IsChanged() {
 				req.Description = req_DescriptionFlag.Value
 			} // CobraFlagsAssign
@@ -50,6 +56,10 @@ func Create(ctx context.Context, parent *cobra.Command, securityGroupService net
 				req.SkipDefaultRules = req_SkipDefaultRulesFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "SecurityGroupService.Create", nil, map[string]any{"req": req}) {
+				return nil
+			}
+
 			result, err := securityGroupService.Create(ctx, req)
 
 			if err != nil {
@@ -68,6 +78,8 @@ func Create(ctx context.Context, parent *cobra.Command, securityGroupService net
 
 	req_SkipDefaultRulesFlag = flags.NewBool(cmd, "skip-default-rules", false, "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
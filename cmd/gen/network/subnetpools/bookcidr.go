@@ -13,10 +13,10 @@ package subnetpools
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -40,6 +40,10 @@ func BookCIDR(ctx context.Context, parent *cobra.Command, subnetPoolService netw
 
 			req := networkSdk.BookCIDRRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var id string // ServiceSDKParamCreate
 
 			if len(args) > 0 {
@@ -48,7 +52,7 @@ func BookCIDR(ctx context.Context, parent *cobra.Command, subnetPoolService netw
 			if idFlag.IsChanged() {
 				id = *idFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o id como argumento ou usar a flag --id")
+				return cmdutils.NewMissingArgError("id")
 			} // CobraFlagsAssign
 
 			if req_CIDRFlag.IsChanged() {
@@ -59,6 +63,10 @@ func BookCIDR(ctx context.Context, parent *cobra.Command, subnetPoolService netw
 				req.Mask = req_MaskFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "SubnetPoolService.BookCIDR", map[string]any{"id": id}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			bookcidrresponse, err := subnetPoolService.BookCIDR(ctx, id, req)
 
 			if err != nil {
@@ -77,6 +85,8 @@ func BookCIDR(ctx context.Context, parent *cobra.Command, subnetPoolService netw
 
 	req_MaskFlag = flags.NewInt(cmd, "mask", 0, "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
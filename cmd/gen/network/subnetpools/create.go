@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -40,6 +42,10 @@ func Create(ctx context.Context, parent *cobra.Command, subnetPoolService networ
 
 			req := networkSdk.CreateSubnetPoolRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			if req_CIDRFlag.IsChanged() {
 				req.CIDR = req_CIDRFlag.Value
 			} // CobraFlagsAssign
@@ -56,6 +62,10 @@ func Create(ctx context.Context, parent *cobra.Command, subnetPoolService networ
 				req.Type = req_TypeFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "SubnetPoolService.Create", nil, map[string]any{"req": req}) {
+				return nil
+			}
+
 			result, err := subnetPoolService.Create(ctx, req)
 
 			if err != nil {
@@ -76,6 +86,8 @@ func Create(ctx context.Context, parent *cobra.Command, subnetPoolService networ
 
 	req_TypeFlag = flags.NewStr(cmd, "type", "", "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -13,7 +13,7 @@ package subnetpools
 import (
 	"context"
 
-	"fmt"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
 
 	"github.com/spf13/cobra"
 
@@ -36,6 +36,10 @@ func UnbookCIDR(ctx context.Context, parent *cobra.Command, subnetPoolService ne
 
 			req := networkSdk.UnbookCIDRRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var id string // ServiceSDKParamCreate
 
 			if len(args) > 0 {
@@ -44,13 +48,17 @@ func UnbookCIDR(ctx context.Context, parent *cobra.Command, subnetPoolService ne
 			if idFlag.IsChanged() {
 				id = *idFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o id como argumento ou usar a flag --id")
+				return cmdutils.NewMissingArgError("id")
 			} // CobraFlagsAssign
 
 			if req_CIDRFlag.IsChanged() {
 				req.CIDR = *req_CIDRFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "SubnetPoolService.UnbookCIDR", map[string]any{"id": id}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			err := subnetPoolService.UnbookCIDR(ctx, id, req)
 
 			if err != nil {
@@ -65,6 +73,8 @@ func UnbookCIDR(ctx context.Context, parent *cobra.Command, subnetPoolService ne
 
 	req_CIDRFlag = flags.NewStr(cmd, "cidr", "", "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -13,10 +13,10 @@ package subnets
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -38,6 +38,10 @@ func Update(ctx context.Context, parent *cobra.Command, subnetService networkSdk
 
 			req := networkSdk.SubnetPatchRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var id string // ServiceSDKParamCreate
 
 			if len(args) > 0 {
@@ -46,13 +50,17 @@ func Update(ctx context.Context, parent *cobra.Command, subnetService networkSdk
 			if idFlag.IsChanged() {
 				id = *idFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o id como argumento ou usar a flag --id")
+				return cmdutils.NewMissingArgError("id")
 			} // CobraFlagsAssign
 
 			if req_DNSNameserversFlag.IsChanged() {
 				req.DNSNameservers = req_DNSNameserversFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "SubnetService.Update", map[string]any{"id": id}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			subnetresponseid, err := subnetService.Update(ctx, id, req)
 
 			if err != nil {
@@ -69,6 +77,8 @@ func Update(ctx context.Context, parent *cobra.Command, subnetService networkSdk
 
 	req_DNSNameserversFlag = flags.NewStrSlice(cmd, "dnsnameservers", []string{}, "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
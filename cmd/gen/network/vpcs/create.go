@@ -15,6 +15,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -36,6 +38,10 @@ func Create(ctx context.Context, parent *cobra.Command, vPCService networkSdk.VP
 
 			req := networkSdk.CreateVPCRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			if req_DescriptionFlag.IsChanged() {
 				req.Description = req_DescriptionFlag.Value
 			} // CobraFlagsAssign
@@ -44,6 +50,10 @@ func Create(ctx context.Context, parent *cobra.Command, vPCService networkSdk.VP
 				req.Name = *req_NameFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "VPCService.Create", nil, map[string]any{"req": req}) {
+				return nil
+			}
+
 			result, err := vPCService.Create(ctx, req)
 
 			if err != nil {
@@ -60,6 +70,8 @@ func Create(ctx context.Context, parent *cobra.Command, vPCService networkSdk.VP
 
 	req_NameFlag = flags.NewStr(cmd, "name", "", "") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -13,10 +13,10 @@ package vpcs
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -50,6 +50,10 @@ func CreatePort(ctx context.Context, parent *cobra.Command, vPCService networkSd
 
 			req := networkSdk.PortCreateRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var vpcID string // ServiceSDKParamCreate
 
 			if len(args) > 0 {
@@ -58,7 +62,7 @@ func CreatePort(ctx context.Context, parent *cobra.Command, vPCService networkSd
 			if vpcIDFlag.IsChanged() {
 				vpcID = *vpcIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o vpc-id como argumento ou usar a flag --vpc-id")
+				return cmdutils.NewMissingArgError("vpc-id")
 			} // CobraFlagsAssign
 
 			if opts_ZoneFlag.IsChanged() {
@@ -85,6 +89,10 @@ func CreatePort(ctx context.Context, parent *cobra.Command, vPCService networkSd
 				req.Subnets = req_SubnetsFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "VPCService.CreatePort", map[string]any{"vpcID": vpcID}, map[string]any{"req": req, "opts": opts}) {
+				return nil
+			}
+
 			result, err := vPCService.CreatePort(ctx, vpcID, req, opts)
 
 			if err != nil {
@@ -111,6 +119,8 @@ func CreatePort(ctx context.Context, parent *cobra.Command, vPCService networkSd
 
 	vpcIDFlag = flags.NewStr(cmd, "vpc-id", "", " (required)") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -13,10 +13,10 @@ package vpcs
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -38,6 +38,10 @@ func CreatePublicIP(ctx context.Context, parent *cobra.Command, vPCService netwo
 
 			req := networkSdk.PublicIPCreateRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var vpcID string // ServiceSDKParamCreate
 
 			if len(args) > 0 {
@@ -46,13 +50,17 @@ func CreatePublicIP(ctx context.Context, parent *cobra.Command, vPCService netwo
 			if vpcIDFlag.IsChanged() {
 				vpcID = *vpcIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o vpc-id como argumento ou usar a flag --vpc-id")
+				return cmdutils.NewMissingArgError("vpc-id")
 			} // CobraFlagsAssign
 
 			if req_DescriptionFlag.IsChanged() {
 				req.Description = req_DescriptionFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "VPCService.CreatePublicIP", map[string]any{"vpcID": vpcID}, map[string]any{"req": req}) {
+				return nil
+			}
+
 			result, err := vPCService.CreatePublicIP(ctx, vpcID, req)
 
 			if err != nil {
@@ -69,6 +77,8 @@ func CreatePublicIP(ctx context.Context, parent *cobra.Command, vPCService netwo
 
 	vpcIDFlag = flags.NewStr(cmd, "vpc-id", "", " (required)") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
@@ -13,10 +13,10 @@ package vpcs
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -52,6 +52,10 @@ func CreateSubnet(ctx context.Context, parent *cobra.Command, vPCService network
 
 			req := networkSdk.SubnetCreateRequest{} // ServiceSDKParamCreate
 
+			if done, err := cmdutils.LoadRequestBody(cmd, &req); done || err != nil {
+				return err
+			}
+
 			var vpcID string // ServiceSDKParamCreate
 
 			if len(args) > 0 {
@@ -60,7 +64,7 @@ func CreateSubnet(ctx context.Context, parent *cobra.Command, vPCService network
 			if vpcIDFlag.IsChanged() {
 				vpcID = *vpcIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o vpc-id como argumento ou usar a flag --vpc-id")
+				return cmdutils.NewMissingArgError("vpc-id")
 			} // CobraFlagsAssign
 
 			if opts_ZoneFlag.IsChanged() {
@@ -91,6 +95,10 @@ func CreateSubnet(ctx context.Context, parent *cobra.Command, vPCService network
 				req.SubnetPoolID = req_SubnetPoolIDFlag.Value
 			} // CobraFlagsAssign
 
+			if cmdutils.DryRun(cmd, "VPCService.CreateSubnet", map[string]any{"vpcID": vpcID}, map[string]any{"req": req, "opts": opts}) {
+				return nil
+			}
+
 			result, err := vPCService.CreateSubnet(ctx, vpcID, req, opts)
 
 			if err != nil {
@@ -119,6 +127,8 @@ func CreateSubnet(ctx context.Context, parent *cobra.Command, vPCService network
 
 	vpcIDFlag = flags.NewStr(cmd, "vpc-id", "", " (required)") //CobraFlagsCreation
 
+	cmdutils.AddRequestFileFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
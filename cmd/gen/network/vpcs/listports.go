@@ -13,10 +13,10 @@ package vpcs
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -54,7 +54,7 @@ func ListPorts(ctx context.Context, parent *cobra.Command, vPCService networkSdk
 			if vpcIDFlag.IsChanged() {
 				vpcID = *vpcIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o vpc-id como argumento ou usar a flag --vpc-id")
+				return cmdutils.NewMissingArgError("vpc-id")
 			} // CobraFlagsAssign
 
 			if len(args) > 1 {
@@ -63,7 +63,7 @@ func ListPorts(ctx context.Context, parent *cobra.Command, vPCService networkSdk
 			if detailedFlag.IsChanged() {
 				detailed = *detailedFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o detailed como argumento ou usar a flag --detailed")
+				return cmdutils.NewMissingArgError("detailed")
 			} // CobraFlagsAssign
 
 			if opts_LimitFlag.IsChanged() {
@@ -78,11 +78,16 @@ func ListPorts(ctx context.Context, parent *cobra.Command, vPCService networkSdk
 				opts.Sort = opts_SortFlag.Value
 			} // CobraFlagsAssign
 
-			portslist, err := vPCService.ListPorts(ctx, vpcID, detailed, opts)
+			portslist, streamed, err := cmdutils.ListAll(ctx, cmd, &opts.Offset, &opts.Limit, func(ctx context.Context) (any, error) {
+				return vPCService.ListPorts(ctx, vpcID, detailed, opts)
+			})
 
 			if err != nil {
 				return err
 			}
+			if streamed {
+				return nil
+			}
 
 			raw, _ := cmd.Root().PersistentFlags().GetBool("raw")
 			beautiful.NewOutput(raw).PrintData(portslist)
@@ -100,6 +105,8 @@ func ListPorts(ctx context.Context, parent *cobra.Command, vPCService networkSdk
 
 	vpcIDFlag = flags.NewStr(cmd, "vpc-id", "", " (required)") //CobraFlagsCreation
 
+	cmdutils.AddPaginationFlags(cmd) //CobraFlagsCreation
+
 	parent.AddCommand(cmd)
 
 }
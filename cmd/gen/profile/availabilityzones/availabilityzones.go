@@ -11,6 +11,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/cmd/gen/profile/availabilityzones/availabilityzones"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	availabilityzonesSdk "github.com/MagaluCloud/mgc-sdk-go/availabilityzones"
@@ -20,7 +22,11 @@ import (
 
 func AvailabilityzonesCmd(ctx context.Context, parent *cobra.Command, sdkCoreConfig sdk.CoreClient) {
 
-	availabilityzonesService := availabilityzonesSdk.New(&sdkCoreConfig)
+	opts := []availabilityzonesSdk.ClientOption{}
+	if endpoint, ok := cmdutils.EndpointFromContext(ctx); ok {
+		opts = append(opts, availabilityzonesSdk.WithGlobalBasePath(endpoint.GlobalURL()))
+	}
+	availabilityzonesService := availabilityzonesSdk.New(&sdkCoreConfig, opts...)
 
 	availabilityzones.AvailabilityZonesCmd(ctx, parent, availabilityzonesService.AvailabilityZones())
 
@@ -13,12 +13,10 @@ package keys
 import (
 	"context"
 
-	"fmt"
-
-	"github.com/charmbracelet/huh"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
@@ -44,12 +42,18 @@ func Delete(ctx context.Context, parent *cobra.Command, keyService sshkeysSdk.Ke
 			if keyIDFlag.IsChanged() {
 				keyID = *keyIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o key-id como argumento ou usar a flag --key-id")
+				return cmdutils.NewMissingArgError("key-id")
 			} // CobraFlagsAssign
 
-			var confirm bool
-			huh.NewConfirm().Title("This action cannot be undone. Proceed?").Affirmative("Yes").Negative("No").Value(&confirm).Run()
-			if !confirm {
+			if cmdutils.DryRun(cmd, "KeyService.Delete", map[string]any{"keyID": keyID}, nil) {
+				return nil
+			}
+
+			confirmed, confirmErr := cmdutils.Confirm(cmd, "This action cannot be undone. Proceed?")
+			if confirmErr != nil {
+				return confirmErr
+			}
+			if !confirmed {
 				return nil
 			}
 
@@ -13,12 +13,12 @@ package keys
 import (
 	"context"
 
-	"fmt"
-
 	"github.com/magaluCloud/mgccli/beautiful"
 
 	"github.com/spf13/cobra"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
 
 	sshkeysSdk "github.com/MagaluCloud/mgc-sdk-go/sshkeys"
@@ -42,7 +42,7 @@ func Get(ctx context.Context, parent *cobra.Command, keyService sshkeysSdk.KeySe
 			if keyIDFlag.IsChanged() {
 				keyID = *keyIDFlag.Value
 			} else {
-				return fmt.Errorf("é necessário fornecer o key-id como argumento ou usar a flag --key-id")
+				return cmdutils.NewMissingArgError("key-id")
 			} // CobraFlagsAssign
 
 			sshkey, err := keyService.Get(ctx, keyID)
@@ -11,6 +11,8 @@ import (
 
 	"github.com/magaluCloud/mgccli/cmd/gen/profile/sshkeys/keys"
 
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+
 	"github.com/spf13/cobra"
 
 	sdk "github.com/MagaluCloud/mgc-sdk-go/client"
@@ -20,7 +22,11 @@ import (
 
 func SshkeysCmd(ctx context.Context, parent *cobra.Command, sdkCoreConfig sdk.CoreClient) {
 
-	sshkeysService := sshkeysSdk.New(&sdkCoreConfig)
+	opts := []sshkeysSdk.ClientOption{}
+	if endpoint, ok := cmdutils.EndpointFromContext(ctx); ok {
+		opts = append(opts, sshkeysSdk.WithGlobalBasePath(endpoint.GlobalURL()))
+	}
+	sshkeysService := sshkeysSdk.New(&sdkCoreConfig, opts...)
 
 	keys.KeysCmd(ctx, parent, sshkeysService.Keys())
 
@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/magaluCloud/mgccli/cmd/common/config"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// projectGlobalFlags são as flags globais que aceitam valor padrão no
+// .mgc.yaml. O arquivo pode vir de um repositório de terceiros, então flags
+// como --no-confirm, --api-key e --workspace ficam de fora
+var projectGlobalFlags = []string{regionFlag, outputFlag, columnsFlag, queryFlag, "raw", timeoutFlag}
+
+// loadProject procura o .mgc.yaml a partir do diretório atual
+func loadProject() (*config.Project, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return nil, cmdutils.NewCliError(fmt.Sprintf("failed to read the current directory to look for %s: %s", config.ProjectFileName, err))
+	}
+	project, err := config.FindProject(dir)
+	if err != nil {
+		return nil, cmdutils.NewUsageError(err.Error(), fmt.Sprintf("fix or remove the %s file", config.ProjectFileName))
+	}
+	return project, nil
+}
+
+// withProjectDefaults acrescenta aos argumentos as flags definidas em
+// defaults no .mgc.yaml para o comando executado. Flags informadas na linha
+// de comando têm precedência e não são repetidas
+func withProjectDefaults(root *cobra.Command, project *config.Project, args []string) []string {
+	if project == nil || len(project.Defaults) == 0 {
+		return args
+	}
+	cmd, _, err := root.Find(args)
+	if err != nil || cmd == root {
+		return args
+	}
+
+	defaults := project.FlagDefaults(strings.TrimPrefix(cmd.CommandPath(), root.Name()+" "))
+	names := make([]string, 0, len(defaults))
+	for name := range defaults {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	changed := changedFlags(cmd, args)
+	extra := []string{}
+	for _, name := range names {
+		flag := cmd.InheritedFlags().Lookup(name)
+		if flag != nil && !slices.Contains(projectGlobalFlags, flag.Name) {
+			fmt.Fprintf(os.Stderr, "Warning: ignoring default for global flag --%s in %s: only --%s accept defaults in a project file\n",
+				name, project.Path, strings.Join(projectGlobalFlags, ", --"))
+			continue
+		}
+		if flag == nil {
+			flag = cmd.Flags().Lookup(name)
+		}
+		if flag == nil {
+			fmt.Fprintf(os.Stderr, "Warning: ignoring default for unknown flag --%s in %s\n", name, project.Path)
+			continue
+		}
+		if changed[flag.Name] {
+			continue
+		}
+		extra = append(extra, fmt.Sprintf("--%s=%s", flag.Name, defaults[name]))
+	}
+
+	// depois de "--" as flags seriam tratadas como argumentos
+	if end := slices.Index(args, "--"); end >= 0 {
+		return slices.Concat(args[:end], extra, args[end:])
+	}
+	return append(slices.Clone(args), extra...)
+}
+
+// changedFlags retorna as flags informadas em args, interpretadas pelo pflag
+// com as definições do comando. As flags são copiadas para que os valores
+// das flags reais não sejam alterados antes da execução
+func changedFlags(cmd *cobra.Command, args []string) map[string]bool {
+	flags := pflag.NewFlagSet(cmd.Name(), pflag.ContinueOnError)
+	flags.ParseErrorsWhitelist.UnknownFlags = true
+	flags.SetOutput(io.Discard)
+	copyFlag := func(flag *pflag.Flag) {
+		if flags.Lookup(flag.Name) != nil {
+			return
+		}
+		copied := flags.VarPF(discardValue(flag.Value.Type()), flag.Name, flag.Shorthand, "")
+		copied.NoOptDefVal = flag.NoOptDefVal
+	}
+	cmd.Flags().VisitAll(copyFlag)
+	cmd.InheritedFlags().VisitAll(copyFlag)
+
+	// um erro (ex: flag sem valor) só interrompe a leitura; o comando vai
+	// reportá-lo ao ser executado
+	_ = flags.Parse(args)
+
+	changed := map[string]bool{}
+	flags.Visit(func(flag *pflag.Flag) {
+		changed[flag.Name] = true
+	})
+	return changed
+}
+
+// discardValue aceita qualquer valor: só interessa saber se a flag foi informada
+type discardValue string
+
+func (v discardValue) String() string   { return "" }
+func (v discardValue) Set(string) error { return nil }
+func (v discardValue) Type() string     { return string(v) }
@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/magaluCloud/mgccli/cmd/common/config"
+	"github.com/spf13/cobra"
+)
+
+func newProjectTestRoot() *cobra.Command {
+	root := &cobra.Command{Use: "mgc"}
+	root.PersistentFlags().String("region", "", "")
+	root.PersistentFlags().Bool("raw", false, "")
+	root.PersistentFlags().Bool("no-confirm", false, "")
+	root.PersistentFlags().String("api-key", "", "")
+
+	network := &cobra.Command{Use: "network"}
+	vpcs := &cobra.Command{Use: "vpcs"}
+	list := &cobra.Command{Use: "list", Run: func(*cobra.Command, []string) {}}
+	list.Flags().IntP("limit", "l", 0, "")
+	list.Flags().StringP("name", "n", "", "")
+	list.Flags().StringSlice("expand", nil, "")
+
+	root.AddCommand(network)
+	network.AddCommand(vpcs)
+	vpcs.AddCommand(list)
+	return root
+}
+
+func TestWithProjectDefaults(t *testing.T) {
+	project := &config.Project{
+		Path: ".mgc.yaml",
+		Defaults: map[string]map[string]string{
+			"network":           {"region": "br-ne1", "limit": "5"},
+			"network vpcs list": {"limit": "10", "expand": "tags"},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		args     []string
+		expected []string
+	}{
+		{
+			name:     "adds defaults",
+			args:     []string{"network", "vpcs", "list"},
+			expected: []string{"network", "vpcs", "list", "--expand=tags", "--limit=10", "--region=br-ne1"},
+		},
+		{
+			name:     "long flag given",
+			args:     []string{"network", "vpcs", "list", "--limit=3", "--region", "br-se1"},
+			expected: []string{"network", "vpcs", "list", "--limit=3", "--region", "br-se1", "--expand=tags"},
+		},
+		{
+			name:     "short flag given",
+			args:     []string{"network", "vpcs", "list", "-l", "3"},
+			expected: []string{"network", "vpcs", "list", "-l", "3", "--expand=tags", "--region=br-ne1"},
+		},
+		{
+			name:     "short flag combined with value",
+			args:     []string{"network", "vpcs", "list", "-l3"},
+			expected: []string{"network", "vpcs", "list", "-l3", "--expand=tags", "--region=br-ne1"},
+		},
+		{
+			name:     "value starting with the shorthand",
+			args:     []string{"network", "vpcs", "list", "--name", "-lab"},
+			expected: []string{"network", "vpcs", "list", "--name", "-lab", "--expand=tags", "--limit=10", "--region=br-ne1"},
+		},
+		{
+			name:     "arguments after terminator",
+			args:     []string{"network", "vpcs", "list", "--raw", "--", "--limit=3", "-l"},
+			expected: []string{"network", "vpcs", "list", "--raw", "--expand=tags", "--limit=10", "--region=br-ne1", "--", "--limit=3", "-l"},
+		},
+		{
+			name:     "parent command only",
+			args:     []string{"network"},
+			expected: []string{"network", "--region=br-ne1"},
+		},
+		{
+			name:     "root command",
+			args:     []string{"--raw"},
+			expected: []string{"--raw"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := newProjectTestRoot()
+			got := withProjectDefaults(root, project, tt.args)
+			if !slices.Equal(got, tt.expected) {
+				t.Errorf("got %v, want %v", got, tt.expected)
+			}
+
+			// as flags reais só são lidas na execução do comando
+			list, _, _ := root.Find([]string{"network", "vpcs", "list"})
+			if list.Flags().Changed("limit") {
+				t.Error("withProjectDefaults changed the command flags")
+			}
+		})
+	}
+}
+
+func TestWithProjectDefaultsIgnoresUnsafeGlobalFlags(t *testing.T) {
+	project := &config.Project{
+		Path: ".mgc.yaml",
+		Defaults: map[string]map[string]string{
+			"network vpcs list": {"no-confirm": "true", "api-key": "key", "raw": "true", "limit": "10"},
+		},
+	}
+
+	got := withProjectDefaults(newProjectTestRoot(), project, []string{"network", "vpcs", "list"})
+	expected := []string{"network", "vpcs", "list", "--limit=10", "--raw=true"}
+	if !slices.Equal(got, expected) {
+		t.Errorf("got %v, want %v", got, expected)
+	}
+}
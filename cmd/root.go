@@ -3,9 +3,10 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"log/slog"
+	"net/http"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"runtime"
@@ -13,7 +14,6 @@ import (
 	"github.com/magaluCloud/mgccli/beautiful"
 	"github.com/magaluCloud/mgccli/cmd/common/auth"
 	"github.com/magaluCloud/mgccli/cmd/common/config"
-	"github.com/magaluCloud/mgccli/cmd/common/workspace"
 	"github.com/magaluCloud/mgccli/cmd/gen"
 	"github.com/magaluCloud/mgccli/cmd/static"
 	"github.com/magaluCloud/mgccli/i18n"
@@ -26,16 +26,44 @@ import (
 	"github.com/spf13/pflag"
 )
 
+// projectErr, workspaceErr, configErr e endpointErr guardam as falhas ao ler
+// o .mgc.yaml e ao resolver workspace, configs e região/ambiente para que
+// sejam reportadas pelo comando executado, e não como panic na inicialização
+var (
+	projectErr   error
+	workspaceErr error
+	configErr    error
+	endpointErr  error
+)
+
+// initErr retorna a primeira falha da inicialização
+func initErr() error {
+	for _, err := range []error{projectErr, workspaceErr, configErr, endpointErr} {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func RootCmd(ctx context.Context, version string, args cmdutils.ArgsParser) *cobra.Command {
 	manager := i18n.GetInstance()
 
-	workspace := workspace.NewWorkspace().Get()
-	config := config.NewConfig(workspace)
-	auth := auth.NewAuth(workspace)
+	project, err := loadProject()
+	projectErr = err
+	workspace, err := resolveWorkspace(args, project)
+	workspaceErr = err
+	config := config.NewConfig(workspace, project)
+	configErr = applyConfigOverrides(config, args)
+
+	endpoint, err := cmdutils.ResolveEndpoint(config)
+	endpointErr = err
+	auth := auth.NewAuth(workspace, endpoint.Env, newCredentialStore(workspace, config, !isCompletionRequest(args)))
 
 	ctx = context.WithValue(ctx, cmdutils.CXT_WORKSPACE_KEY, workspace)
 	ctx = context.WithValue(ctx, cmdutils.CTX_AUTH_KEY, auth)
 	ctx = context.WithValue(ctx, cmdutils.CXT_CONFIG_KEY, config)
+	ctx = context.WithValue(ctx, cmdutils.CTX_ENDPOINT_KEY, endpoint)
 
 	lang, err := config.Value(cmdutils.CFG_LANG)
 	if err != nil {
@@ -51,6 +79,9 @@ func RootCmd(ctx context.Context, version string, args cmdutils.ArgsParser) *cob
 	}
 
 	rootCmd.SilenceErrors = false
+	rootCmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
+		return cmdutils.NewUsageError(err.Error(), "")
+	})
 
 	rootCmd.AddGroup(&cobra.Group{
 		ID:    "products",
@@ -68,13 +99,23 @@ func RootCmd(ctx context.Context, version string, args cmdutils.ArgsParser) *cob
 	rootCmd.SetCompletionCommandGroupID("other")
 
 	addApiKeyFlag(rootCmd)
+	addColumnsFlag(rootCmd)
+	addDryRunFlag(rootCmd)
 	addLogDebugFlag(rootCmd)
+	addLogFlags(rootCmd)
 	addNoConfirmationFlag(rootCmd)
+	addOutputFlag(rootCmd)
+	addQueryFlag(rootCmd)
 	addRawOutputFlag(rootCmd)
+	addRegionFlag(rootCmd)
 	addTimeoutFlag(rootCmd)
+	addWorkspaceFlag(rootCmd)
 
 	// // Init SDK
 	sdkOptions := []sdk.Option{}
+	if endpointErr == nil {
+		sdkOptions = append(sdkOptions, sdk.WithBaseURL(endpoint.BaseURL()))
+	}
 	timeoutValue, timeoutPresent, _ := args.GetValue(timeoutFlag)
 	if timeoutPresent {
 		timeout, err := strconv.Atoi(timeoutValue)
@@ -92,17 +133,9 @@ func RootCmd(ctx context.Context, version string, args cmdutils.ArgsParser) *cob
 		sdkOptions = append(sdkOptions, sdk.WithAPIKey(apiKey))
 	}
 
-	jwtToken := auth.GetAccessToken(ctx)
-	if jwtToken != "" {
-		sdkOptions = append(sdkOptions, sdk.WithJWToken(jwtToken))
-	}
-
-	debugLevel := slog.LevelError
-	debugLevelValue, debugPresent, _ := args.GetValue(debugLevelFlag)
-	if debugPresent {
-		debugLevel = slog.Level(parseDebugLevel(debugLevelValue))
-	}
-	sdkOptions = append(sdkOptions, sdk.WithLogger(slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: debugLevel}))))
+	logger, traceHTTP := newLogger(args)
+	sdkOptions = append(sdkOptions, sdk.WithLogger(logger))
+	sdkOptions = append(sdkOptions, sdk.WithHTTPClient(&http.Client{Transport: &cmdutils.Transport{Trace: traceHTTP, Logger: logger, Token: auth.GetAccessToken, Endpoint: endpoint}}))
 	sdkOptions = append(sdkOptions, sdk.WithUserAgent(fmt.Sprintf("CLIv2/%s (%s; %s)", version, runtime.GOOS, runtime.GOARCH)))
 
 	sdkCoreConfig := sdk.NewMgcClient(
@@ -114,9 +147,10 @@ func RootCmd(ctx context.Context, version string, args cmdutils.ArgsParser) *cob
 
 	static.RootStatic(rootCmd)
 	gen.RootGen(rootCmd)
+	addCompletions(rootCmd, *sdkCoreConfig)
 
 	beautifulPrint(rootCmd)
-	rootCmd.SetArgs(args.AllArgs())
+	rootCmd.SetArgs(withProjectDefaults(rootCmd, project, args.AllArgs()))
 	return rootCmd
 }
 
@@ -214,7 +248,7 @@ func beautifulPrint(cmd *cobra.Command) {
 						shorthand.Printf(" -%s", flag.Shorthand)
 					}
 					flagDesc := color.New(color.FgWhite)
-					flagDesc.Printf(" %s\n", flag.Usage)
+					flagDesc.Printf(" %s\n", flagUsage(flag))
 				}
 			})
 		}
@@ -233,7 +267,7 @@ func beautifulPrint(cmd *cobra.Command) {
 						shorthand.Printf(" -%s", flag.Shorthand)
 					}
 					flagDesc := color.New(color.FgWhite)
-					flagDesc.Printf(" %s\n", flag.Usage)
+					flagDesc.Printf(" %s\n", flagUsage(flag))
 				}
 			})
 		}
@@ -325,16 +359,45 @@ func beautifulPrint(cmd *cobra.Command) {
 		return nil
 	})
 
+	// comandos com Run também precisam reportar as falhas da inicialização
+	if originalRun := cmd.Run; originalRun != nil && cmd.RunE == nil {
+		cmd.Run = nil
+		cmd.RunE = func(cmd *cobra.Command, args []string) error {
+			originalRun(cmd, args)
+			return nil
+		}
+	}
+
 	// Configurar função de execução personalizada para interceptar outputs
 	originalRunE := cmd.RunE
 	if originalRunE != nil {
 		cmd.RunE = func(cmd *cobra.Command, args []string) error {
-			rawMode := getRawOutputFlag(cmd)
-			beautifulOutput := beautiful.NewOutput(rawMode)
-
-			err := originalRunE(cmd, args)
+			err := initErr()
+			if err == nil {
+				err = setupOutput(cmd)
+			}
+			if err == nil {
+				err = cmdutils.ResolveNames(cmd, args)
+			}
+			if err == nil {
+				err = originalRunE(cmd, args)
+			}
+			if err == nil {
+				if printErr := beautiful.PendingError(); printErr != nil {
+					err = cmdutils.NewCliError(printErr.Error())
+				}
+			}
 
 			if err != nil {
+				rawMode := getRawOutputFlag(cmd)
+				beautifulOutput := beautiful.NewOutput(rawMode)
+				if rawMode || explicitJSONOutput(cmd) {
+					beautifulOutput.PrintErrorData(cmdutils.NewErrorModel(err))
+					cmd.SetContext(context.WithValue(cmd.Context(), cmdutils.CTX_ERROR_HANDLED, true))
+					cmd.SilenceErrors = true
+					return err
+				}
+
 				msg, detail := cmdutils.ParseSDKError(err)
 
 				if detail != "" {
@@ -356,6 +419,12 @@ func beautifulPrint(cmd *cobra.Command) {
 	}
 }
 
+// flagUsage alinha as linhas seguintes de descrições com mais de uma linha
+// (ex: estrutura das flags JSON) à coluna da descrição
+func flagUsage(flag *pflag.Flag) string {
+	return strings.ReplaceAll(flag.Usage, "\n", "\n"+strings.Repeat(" ", 20))
+}
+
 func usageTemplate(manager *i18n.Manager) string {
 	usageTemplate := `{{if .Runnable}}` + manager.T("cli.usage") + `:{{if .HasAvailableFlags}} [FLAGS]{{end}}{{if .HasAvailableSubCommands}} [COMANDO]{{end}}{{if gt .Aliases 0}}
 
@@ -20,6 +20,10 @@ func AuthCmd(parent *cobra.Command) {
 
 	// Adicionar subcomandos
 	cmd.AddCommand(NewLoginCommand(parent.Context()))
+	cmd.AddCommand(NewLogoutCommand(parent.Context()))
+	cmd.AddCommand(NewStatusCommand(parent.Context()))
+	cmd.AddCommand(NewTokenCommand(parent.Context()))
+	cmd.AddCommand(NewWhoamiCommand(parent.Context()))
 
 	parent.AddCommand(cmd)
 }
@@ -0,0 +1,29 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/magaluCloud/mgccli/cmd/common/auth"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+	"github.com/spf13/cobra"
+)
+
+// NewLogoutCommand cria o comando que remove as credenciais do workspace
+func NewLogoutCommand(ctx context.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "logout",
+		Short: "Remove the saved credentials",
+		Long:  "Remove the access and refresh tokens saved in the current workspace",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			authValue := ctx.Value(cmdutils.CTX_AUTH_KEY).(auth.Auth)
+			if err := authValue.Logout(); err != nil {
+				return cmdutils.NewCliError(fmt.Sprintf("failed to remove credentials: %s", err))
+			}
+			fmt.Fprintln(os.Stderr, "Logged out")
+			return nil
+		},
+	}
+	return cmd
+}
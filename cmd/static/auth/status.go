@@ -0,0 +1,99 @@
+package auth
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/magaluCloud/mgccli/beautiful"
+	"github.com/magaluCloud/mgccli/cmd/common/auth"
+	"github.com/magaluCloud/mgccli/cmd/common/workspace"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	methodAPIKey = "api_key"
+	methodOAuth  = "oauth"
+	methodNone   = "none"
+)
+
+// authStatus descreve a credencial usada pelas chamadas da CLI
+type authStatus struct {
+	Workspace       string     `json:"workspace"`
+	Authenticated   bool       `json:"authenticated"`
+	Method          string     `json:"method"`
+	APIKeySource    string     `json:"api_key_source,omitempty"`
+	Email           string     `json:"email,omitempty"`
+	TenantID        string     `json:"tenant_id,omitempty"`
+	ExpiresAt       *time.Time `json:"expires_at,omitempty"`
+	Expired         bool       `json:"expired,omitempty"`
+	HasRefreshToken bool       `json:"has_refresh_token"`
+}
+
+// NewStatusCommand cria o comando que mostra a credencial ativa
+func NewStatusCommand(ctx context.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show the active credential",
+		Long:  "Show which credential is used by the CLI (API key or OAuth token), its expiration and the current workspace",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			raw, _ := cmd.Root().PersistentFlags().GetBool("raw")
+			beautiful.NewOutput(raw).PrintData(buildStatus(ctx, cmd))
+			return nil
+		},
+	}
+	return cmd
+}
+
+func buildStatus(ctx context.Context, cmd *cobra.Command) authStatus {
+	authValue := ctx.Value(cmdutils.CTX_AUTH_KEY).(auth.Auth)
+	ws := ctx.Value(cmdutils.CXT_WORKSPACE_KEY).(workspace.Workspace)
+
+	status := authStatus{
+		Workspace:       ws.Name(),
+		Method:          methodNone,
+		HasRefreshToken: authValue.GetRefreshToken() != "",
+	}
+
+	if claims, err := authValue.TokenClaims(); err == nil {
+		status.Method = methodOAuth
+		status.Authenticated = true
+		status.Email = claims.Email
+		status.TenantID = claims.TenantIDWithType
+		if claims.ExpiresAt != nil {
+			expiresAt := claims.ExpiresAt.Time
+			status.ExpiresAt = &expiresAt
+			status.Expired = time.Now().After(expiresAt)
+			status.Authenticated = !status.Expired || status.HasRefreshToken
+		}
+	}
+
+	// a API key tem precedência sobre o token, como na configuração do SDK
+	if source := apiKeySource(cmd); source != "" {
+		status.Method = methodAPIKey
+		status.APIKeySource = source
+		status.Authenticated = true
+	}
+	return status
+}
+
+// apiKeySource informa de onde vem a API key em uso, seguindo a mesma ordem
+// da inicialização da CLI: variável de ambiente e depois --api-key
+func apiKeySource(cmd *cobra.Command) string {
+	if os.Getenv(cmdutils.ENV_API_KEY.String()) != "" {
+		return "env " + cmdutils.ENV_API_KEY.String()
+	}
+	if apiKey, _ := cmd.Root().PersistentFlags().GetString("api-key"); apiKey != "" {
+		return "flag --api-key"
+	}
+	return ""
+}
+
+func notLoggedInError() error {
+	return &cmdutils.CliError{
+		Message: "not logged in",
+		Details: "run 'auth login' to authenticate",
+		Kind:    cmdutils.ErrorKindAuth,
+	}
+}
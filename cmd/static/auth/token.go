@@ -0,0 +1,78 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/magaluCloud/mgccli/beautiful"
+	"github.com/magaluCloud/mgccli/cmd/common/auth"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+	"github.com/spf13/cobra"
+)
+
+type tokenInfo struct {
+	AccessToken string       `json:"access_token"`
+	Claims      *tokenClaims `json:"claims,omitempty"`
+}
+
+type tokenClaims struct {
+	Email     string    `json:"email"`
+	TenantID  string    `json:"tenant_id"`
+	Subject   string    `json:"subject,omitempty"`
+	Scopes    []string  `json:"scopes"`
+	IssuedAt  time.Time `json:"issued_at,omitzero"`
+	ExpiresAt time.Time `json:"expires_at,omitzero"`
+}
+
+// NewTokenCommand cria o comando que imprime o token de acesso
+func NewTokenCommand(ctx context.Context) *cobra.Command {
+	var showClaims bool
+
+	cmd := &cobra.Command{
+		Use:   "token",
+		Short: "Print the access token",
+		Long:  "Print the access token of the current workspace, refreshing it when expired. Without --output only the token is printed, for use in scripts",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			authValue := ctx.Value(cmdutils.CTX_AUTH_KEY).(auth.Auth)
+			token := authValue.GetAccessToken(ctx)
+			if token == "" {
+				return notLoggedInError()
+			}
+
+			if !showClaims && !cmd.Flags().Changed("output") {
+				fmt.Println(token)
+				return nil
+			}
+
+			info := tokenInfo{AccessToken: token}
+			if showClaims {
+				claims, err := authValue.TokenClaims()
+				if err != nil {
+					return cmdutils.NewCliError(fmt.Sprintf("cannot decode access token: %s", err))
+				}
+				info.Claims = &tokenClaims{
+					Email:    claims.Email,
+					TenantID: claims.TenantIDWithType,
+					Subject:  claims.Subject,
+					Scopes:   strings.Fields(claims.ScopesStr),
+				}
+				if claims.IssuedAt != nil {
+					info.Claims.IssuedAt = claims.IssuedAt.Time
+				}
+				if claims.ExpiresAt != nil {
+					info.Claims.ExpiresAt = claims.ExpiresAt.Time
+				}
+			}
+
+			raw, _ := cmd.Root().PersistentFlags().GetBool("raw")
+			beautiful.NewOutput(raw).PrintData(info)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&showClaims, "claims", false, "Also print the decoded claims of the token (email, tenant, scopes, expiration)")
+
+	return cmd
+}
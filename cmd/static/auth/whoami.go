@@ -0,0 +1,44 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/magaluCloud/mgccli/beautiful"
+	"github.com/magaluCloud/mgccli/cmd/common/auth"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+	"github.com/spf13/cobra"
+)
+
+type whoami struct {
+	Email    string `json:"email"`
+	TenantID string `json:"tenant_id"`
+	Subject  string `json:"subject,omitempty"`
+}
+
+// NewWhoamiCommand cria o comando que mostra o usuário autenticado
+func NewWhoamiCommand(ctx context.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "whoami",
+		Short: "Show the logged in user",
+		Long:  "Show the email and tenant of the user logged in with 'auth login'",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			authValue := ctx.Value(cmdutils.CTX_AUTH_KEY).(auth.Auth)
+			if authValue.GetAccessToken(ctx) == "" {
+				return notLoggedInError()
+			}
+			claims, err := authValue.TokenClaims()
+			if err != nil {
+				return notLoggedInError()
+			}
+
+			raw, _ := cmd.Root().PersistentFlags().GetBool("raw")
+			beautiful.NewOutput(raw).PrintData(whoami{
+				Email:    claims.Email,
+				TenantID: claims.TenantIDWithType,
+				Subject:  claims.Subject,
+			})
+			return nil
+		},
+	}
+	return cmd
+}
@@ -2,46 +2,121 @@ package config
 
 import (
 	"fmt"
-	"reflect"
+	"slices"
+	"sort"
+	"strings"
 
 	"github.com/fatih/color"
 	"github.com/magaluCloud/mgccli/cmd/common/config"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
 	"github.com/spf13/cobra"
 )
 
 func List(config config.Config) *cobra.Command {
+	var showSource bool
+	var scope string
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "Listar configurações",
 		Long:  `Listar configurações`,
-		Run: func(cmd *cobra.Command, args []string) {
-
-			configMap, err := config.List()
+		RunE: func(cmd *cobra.Command, args []string) error {
+			configMap, err := effectiveItems(config)
 			if err != nil {
-				fmt.Println("Erro ao listar configurações:", err)
-				return
+				return cmdutils.NewCliError(fmt.Sprintf("erro ao listar configurações: %s", err))
+			}
+			if scope != "" {
+				if !slices.Contains(scopes(), scope) {
+					return cmdutils.NewUsageError(
+						fmt.Sprintf("escopo desconhecido: %s", scope),
+						fmt.Sprintf("escopos disponíveis: %s", strings.Join(scopes(), ", ")),
+					)
+				}
+				configMap = filterScope(configMap, scope)
+			}
+			if showSource {
+				printSources(configMap)
+				return nil
 			}
 			for key, value := range configMap {
-				fmt.Printf("Name: %s\n   Value: %v\n   Type: %s\n   Description: %s\n   Validator: %s\n   Default: %v\n   Scope: %s\n\n",
+				fmt.Printf("Name: %s\n   Value: %v\n   Type: %s\n   Description: %s\n   Validator: %s\n   Default: %v\n   Scope: %s\n   Source: %s\n\n",
 					color.BlueString(key),
-					color.YellowString(fmt.Sprintf("%v", valueOrDefault(value.Value, value.Default))),
+					color.YellowString(valueString(value.Value)),
 					value.Type,
 					value.Description,
 					validatorOrEmpty(value.Validator),
 					value.Default,
 					value.Scope,
+					value.Source,
 				)
 			}
+			return nil
 		},
 	}
+	cmd.Flags().BoolVar(&showSource, "show-source", false, "Show only the effective value of each config and where it comes from")
+	cmd.Flags().StringVar(&scope, "scope", "", "Show only the configs of a scope (e.g. global, object-storage)")
+	cmd.RegisterFlagCompletionFunc("scope", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return scopes(), cobra.ShellCompDirectiveNoFileComp
+	})
 	return cmd
 }
 
-func valueOrDefault(value any, defaultValue any) any {
-	if value == nil || reflect.ValueOf(value).IsZero() {
-		return defaultValue
+func scopes() []string {
+	return config.Scopes()
+}
+
+func filterScope(configMap map[string]*config.ConfigItem, scope string) map[string]*config.ConfigItem {
+	filtered := map[string]*config.ConfigItem{}
+	for key, value := range configMap {
+		if value.Scope == scope {
+			filtered[key] = value
+		}
+	}
+	return filtered
+}
+
+// printSources exibe uma linha por config com o valor efetivo e sua origem
+// (ex: region = br-ne1 (env: MGC_REGION))
+func printSources(configMap map[string]*config.ConfigItem) {
+	keys := make([]string, 0, len(configMap))
+	for key := range configMap {
+		keys = append(keys, key)
 	}
-	return value
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value := configMap[key]
+		source := value.Source
+		if value.Origin != "" {
+			source = fmt.Sprintf("%s: %s", value.Source, value.Origin)
+		}
+		fmt.Printf("%s = %s (%s)\n",
+			color.BlueString(key),
+			color.YellowString(valueString(value.Value)),
+			source,
+		)
+	}
+}
+
+// effectiveItems lista as configs com o valor usado pela CLI, resolvido por
+// Get (ex: default no lugar de um valor zero no cli.yaml)
+func effectiveItems(cfg config.Config) (map[string]*config.ConfigItem, error) {
+	items, err := cfg.List()
+	if err != nil {
+		return nil, err
+	}
+	for key := range items {
+		item, err := cfg.Get(key)
+		if err != nil {
+			return nil, err
+		}
+		items[key] = item
+	}
+	return items, nil
+}
+
+// valueString exibe listas e mapas no mesmo formato aceito por config set
+func valueString(value any) string {
+	return config.NewValue(value).String()
 }
 
 func validatorOrEmpty(validator *string) string {
@@ -0,0 +1,69 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/magaluCloud/mgccli/cmd/common/config"
+	"github.com/magaluCloud/mgccli/cmd/common/workspace"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+)
+
+func TestEffectiveItems(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	ws := workspace.NewWorkspace().Current()
+	if err := os.MkdirAll(ws.Dir(), 0700); err != nil {
+		t.Fatal(err)
+	}
+	cliYAML := "workers: 0\nchunk_size: 16\n"
+	if err := os.WriteFile(path.Join(ws.Dir(), "cli.yaml"), []byte(cliYAML), 0600); err != nil {
+		t.Fatal(err)
+	}
+	cfg := config.NewConfig(ws, nil)
+	if err := cfg.Override("no_confirm", false, config.SourceEnv, "MGC_NO_CONFIRM"); err != nil {
+		t.Fatal(err)
+	}
+
+	items, err := effectiveItems(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		key    string
+		value  any
+		source string
+	}{
+		{key: "workers", value: 5, source: config.SourceDefault},
+		{key: "chunk-size", value: 16, source: config.SourceWorkspace},
+		{key: "no-confirm", value: false, source: config.SourceEnv},
+		{key: "default-output", value: "json", source: config.SourceDefault},
+	}
+	for _, tt := range tests {
+		item, ok := items[tt.key]
+		if !ok {
+			t.Fatalf("%s not listed", tt.key)
+		}
+		if item.Value != tt.value || item.Source != tt.source {
+			t.Errorf("%s = %v (%s), want %v (%s)", tt.key, item.Value, item.Source, tt.value, tt.source)
+		}
+	}
+}
+
+func TestListUnknownScope(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	cfg := config.NewConfig(workspace.NewWorkspace(), nil)
+
+	cmd := List(cfg)
+	cmd.SetArgs([]string{"--scope", "unknown"})
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+	err := cmd.Execute()
+
+	var cliErr *cmdutils.CliError
+	if !errors.As(err, &cliErr) || cliErr.Kind != cmdutils.ErrorKindUsage {
+		t.Fatalf("got %v, want a usage error", err)
+	}
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/fatih/color"
 	"github.com/magaluCloud/mgccli/cmd/common/config"
@@ -25,6 +26,9 @@ func Set(config config.Config) *cobra.Command {
 				return
 			}
 			fmt.Printf("%s: %v\n", color.BlueString(args[0]), color.YellowString(args[1]))
+			if item, err := config.Get(args[0]); err == nil && item.Overridden() {
+				fmt.Fprintf(os.Stderr, "Warning: the effective value still comes from %s (%s)\n", item.Source, item.Origin)
+			}
 		},
 	}
 	return cmd
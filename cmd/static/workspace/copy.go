@@ -0,0 +1,43 @@
+package workspace
+
+import (
+	"fmt"
+
+	"github.com/magaluCloud/mgccli/cmd/common/workspace"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
+	"github.com/spf13/cobra"
+)
+
+func CopyCmd(parent *cobra.Command) *cobra.Command {
+	var sourceFlag *flags.StrFlag
+	var targetFlag *flags.StrFlag
+
+	cmd := &cobra.Command{
+		Use:   "copy [source] [target]",
+		Short: "Copy a workspace",
+		Long:  "Copy a workspace, including its settings and credentials, to a new workspace",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			workspace := parent.Context().Value(cmdutils.CXT_WORKSPACE_KEY).(workspace.Workspace)
+			if len(args) > 0 {
+				cmd.Flags().Set("source", args[0])
+			}
+			if len(args) > 1 {
+				cmd.Flags().Set("target", args[1])
+			}
+			if !sourceFlag.IsChanged() || !targetFlag.IsChanged() {
+				return cmdutils.NewCliError("source and target workspaces are required")
+			}
+
+			err := workspace.Copy(*sourceFlag.Value, *targetFlag.Value)
+			if err != nil {
+				return cmdutils.NewCliError(err.Error())
+			}
+			fmt.Printf("Workspace %s copied to %s\n", *sourceFlag.Value, *targetFlag.Value)
+			return nil
+		},
+	}
+	sourceFlag = flags.NewStr(cmd, "source", "", "Name of the workspace to copy")
+	targetFlag = flags.NewStr(cmd, "target", "", "Name of the new workspace")
+	return cmd
+}
@@ -2,6 +2,7 @@ package workspace
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/magaluCloud/mgccli/cmd/common/workspace"
 	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
@@ -12,10 +13,11 @@ import (
 func DeleteCmd(parent *cobra.Command) *cobra.Command {
 	var name string
 	var nameFlag *flags.StrFlag
+	var forceFlag *flags.BoolFlag
 	cmd := &cobra.Command{
 		Use:   "delete [name]",
 		Short: "Delete a workspace",
-		Long:  "Delete a workspace",
+		Long:  "Delete a workspace. With --force, a workspace with files is archived in the .archive directory of the CLI config and then removed",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			workspace := parent.Context().Value(cmdutils.CXT_WORKSPACE_KEY).(workspace.Workspace)
 			if len(args) > 0 {
@@ -26,14 +28,38 @@ func DeleteCmd(parent *cobra.Command) *cobra.Command {
 			} else {
 				return cmdutils.NewCliError("workspace name is required")
 			}
-			err := workspace.Delete(name)
+			confirmed, err := cmdutils.Confirm(cmd, fmt.Sprintf("Delete workspace %s and all of its settings and credentials?", name))
 			if err != nil {
+				return err
+			}
+			if !confirmed {
+				return nil
+			}
+
+			if !*forceFlag.Value {
+				err = workspace.Delete(name)
+				if err != nil {
+					return cmdutils.NewCliError(err.Error())
+				}
+				fmt.Println("Workspace deleted successfully")
+				return nil
+			}
+
+			archivePath, err := workspace.Archive(name)
+			if err != nil {
+				return cmdutils.NewCliError(fmt.Sprintf("failed to archive workspace: %s", err))
+			}
+			err = workspace.DeleteAll(name)
+			if err != nil {
+				// o arquivo só é mantido quando o workspace foi removido
+				os.Remove(archivePath)
 				return cmdutils.NewCliError(err.Error())
 			}
-			fmt.Println("Workspace deleted successfully")
+			fmt.Printf("Workspace deleted successfully, archived at %s\n", archivePath)
 			return nil
 		},
 	}
 	nameFlag = flags.NewStr(cmd, "name", "", "Name of the workspace")
+	forceFlag = flags.NewBool(cmd, "force", false, "Archive and remove the workspace even when it has files")
 	return cmd
 }
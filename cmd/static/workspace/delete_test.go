@@ -0,0 +1,72 @@
+package workspace
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/magaluCloud/mgccli/cmd/common/workspace"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+	"github.com/spf13/cobra"
+)
+
+// runDelete executa "workspace delete --force name" sem pedir confirmação
+func runDelete(t *testing.T, ws workspace.Workspace, name string) error {
+	t.Helper()
+	root := &cobra.Command{Use: "mgc"}
+	root.PersistentFlags().Bool(cmdutils.NoConfirmFlag, false, "")
+	parent := &cobra.Command{Use: "workspace"}
+	root.AddCommand(parent)
+	parent.AddCommand(DeleteCmd(parent))
+	ctx := context.WithValue(context.Background(), cmdutils.CXT_WORKSPACE_KEY, ws)
+	parent.SetContext(ctx)
+	root.SetContext(ctx)
+	root.SetArgs([]string{"workspace", "delete", "--force", "--no-confirm", name})
+	root.SilenceErrors = true
+	root.SilenceUsage = true
+	return root.ExecuteContext(root.Context())
+}
+
+func archives(t *testing.T, dir string) []string {
+	t.Helper()
+	files, err := filepath.Glob(filepath.Join(dir, "mgc", ".archive", "*.tar.gz"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return files
+}
+
+func TestDeleteForce(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	ws := workspace.NewWorkspace()
+	for _, name := range []string{"team", "other"} {
+		if err := ws.Create(name); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "mgc", name, "cli.yaml"), []byte("region: br-ne1\n"), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ws.Set("team"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := runDelete(t, ws, "team"); err == nil {
+		t.Fatal("expected an error deleting the selected workspace")
+	}
+	if files := archives(t, dir); len(files) != 0 {
+		t.Errorf("archive left behind after a failed delete: %v", files)
+	}
+
+	if err := runDelete(t, ws, "other"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "mgc", "other")); !os.IsNotExist(err) {
+		t.Errorf("workspace other still exists: %v", err)
+	}
+	if files := archives(t, dir); len(files) != 1 {
+		t.Errorf("got archives %v, want one", files)
+	}
+}
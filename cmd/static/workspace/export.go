@@ -0,0 +1,65 @@
+package workspace
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/magaluCloud/mgccli/cmd/common/workspace"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
+	"github.com/spf13/cobra"
+)
+
+func ExportCmd(parent *cobra.Command) *cobra.Command {
+	var nameFlag *flags.StrFlag
+	var fileFlag *flags.StrFlag
+	var credentialsFlag *flags.BoolFlag
+
+	cmd := &cobra.Command{
+		Use:   "export [name]",
+		Short: "Export a workspace",
+		Long:  "Export the settings of a workspace to a tar.gz file that can be imported with 'workspace import'. Credentials are only exported with --include-credentials",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ws := parent.Context().Value(cmdutils.CXT_WORKSPACE_KEY).(workspace.Workspace)
+			if len(args) > 0 {
+				cmd.Flags().Set("name", args[0])
+			}
+			name := ws.Name()
+			if nameFlag.IsChanged() {
+				name = *nameFlag.Value
+			}
+			file := *fileFlag.Value
+			if file == "" {
+				file = name + ".tar.gz"
+			}
+
+			var out io.Writer = os.Stdout
+			if file != "-" {
+				// o arquivo pode conter credenciais
+				f, err := os.OpenFile(file, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+				if err != nil {
+					return cmdutils.NewCliError(err.Error())
+				}
+				defer f.Close()
+				out = f
+			}
+
+			err := ws.Export(name, out, *credentialsFlag.Value)
+			if err != nil {
+				if file != "-" {
+					os.Remove(file)
+				}
+				return cmdutils.NewCliError(err.Error())
+			}
+			if file != "-" {
+				fmt.Printf("Workspace %s exported to %s\n", name, file)
+			}
+			return nil
+		},
+	}
+	nameFlag = flags.NewStr(cmd, "name", "", "Name of the workspace. Defaults to the current workspace")
+	fileFlag = flags.NewStrP(cmd, "file", "f", "", "Destination file, '-' for stdout. Defaults to <name>.tar.gz")
+	credentialsFlag = flags.NewBool(cmd, "include-credentials", false, "Also export the tokens and API keys of the workspace")
+	return cmd
+}
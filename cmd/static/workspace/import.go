@@ -0,0 +1,54 @@
+package workspace
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/magaluCloud/mgccli/cmd/common/workspace"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
+	"github.com/spf13/cobra"
+)
+
+func ImportCmd(parent *cobra.Command) *cobra.Command {
+	var fileFlag *flags.StrFlag
+	var nameFlag *flags.StrFlag
+	var forceFlag *flags.BoolFlag
+
+	cmd := &cobra.Command{
+		Use:   "import [file]",
+		Short: "Import a workspace",
+		Long:  "Create a workspace from a file generated by 'workspace export' ('-' reads from stdin)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ws := parent.Context().Value(cmdutils.CXT_WORKSPACE_KEY).(workspace.Workspace)
+			if len(args) > 0 {
+				cmd.Flags().Set("file", args[0])
+			}
+			if !fileFlag.IsChanged() {
+				return cmdutils.NewCliError("file is required")
+			}
+
+			var in io.Reader = os.Stdin
+			if *fileFlag.Value != "-" {
+				f, err := os.Open(*fileFlag.Value)
+				if err != nil {
+					return cmdutils.NewCliError(err.Error())
+				}
+				defer f.Close()
+				in = f
+			}
+
+			name, err := ws.Import(*nameFlag.Value, in, *forceFlag.Value)
+			if err != nil {
+				return cmdutils.NewCliError(err.Error())
+			}
+			fmt.Printf("Workspace %s imported successfully\n", name)
+			return nil
+		},
+	}
+	fileFlag = flags.NewStr(cmd, "file", "", "File generated by 'workspace export'")
+	nameFlag = flags.NewStr(cmd, "name", "", "Name of the new workspace. Defaults to the exported name")
+	forceFlag = flags.NewBool(cmd, "force", false, "Overwrite the files of an existing workspace")
+	return cmd
+}
@@ -0,0 +1,43 @@
+package workspace
+
+import (
+	"fmt"
+
+	"github.com/magaluCloud/mgccli/cmd/common/workspace"
+	cmdutils "github.com/magaluCloud/mgccli/cmd_utils"
+	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
+	"github.com/spf13/cobra"
+)
+
+func RenameCmd(parent *cobra.Command) *cobra.Command {
+	var nameFlag *flags.StrFlag
+	var newNameFlag *flags.StrFlag
+
+	cmd := &cobra.Command{
+		Use:   "rename [name] [new-name]",
+		Short: "Rename a workspace",
+		Long:  "Rename a workspace. The selected workspace stays selected under the new name",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			workspace := parent.Context().Value(cmdutils.CXT_WORKSPACE_KEY).(workspace.Workspace)
+			if len(args) > 0 {
+				cmd.Flags().Set("name", args[0])
+			}
+			if len(args) > 1 {
+				cmd.Flags().Set("new-name", args[1])
+			}
+			if !nameFlag.IsChanged() || !newNameFlag.IsChanged() {
+				return cmdutils.NewCliError("current and new workspace names are required")
+			}
+
+			err := workspace.Rename(*nameFlag.Value, *newNameFlag.Value)
+			if err != nil {
+				return cmdutils.NewCliError(err.Error())
+			}
+			fmt.Printf("Workspace %s renamed to %s\n", *nameFlag.Value, *newNameFlag.Value)
+			return nil
+		},
+	}
+	nameFlag = flags.NewStr(cmd, "name", "", "Name of the workspace")
+	newNameFlag = flags.NewStr(cmd, "new-name", "", "New name of the workspace")
+	return cmd
+}
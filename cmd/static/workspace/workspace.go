@@ -12,10 +12,14 @@ func WorkspaceCmd(parent *cobra.Command) {
 		GroupID: "settings",
 	}
 
+	cmd.AddCommand(CopyCmd(parent))
 	cmd.AddCommand(CreateCmd(parent))
 	cmd.AddCommand(DeleteCmd(parent))
+	cmd.AddCommand(ExportCmd(parent))
 	cmd.AddCommand(GetCmd(parent))
+	cmd.AddCommand(ImportCmd(parent))
 	cmd.AddCommand(ListCmd(parent))
+	cmd.AddCommand(RenameCmd(parent))
 	cmd.AddCommand(SetCmd(parent))
 	cmd.AddCommand(SelectCmd(parent))
 
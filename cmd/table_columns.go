@@ -0,0 +1,24 @@
+package cmd
+
+import (
+	"github.com/magaluCloud/mgccli/beautiful"
+
+	blockstorageSdk "github.com/MagaluCloud/mgc-sdk-go/blockstorage"
+	computeSdk "github.com/MagaluCloud/mgc-sdk-go/compute"
+	containerregistrySdk "github.com/MagaluCloud/mgc-sdk-go/containerregistry"
+	dbaasSdk "github.com/MagaluCloud/mgc-sdk-go/dbaas"
+	kubernetesSdk "github.com/MagaluCloud/mgc-sdk-go/kubernetes"
+	networkSdk "github.com/MagaluCloud/mgc-sdk-go/network"
+)
+
+// Colunas padrão para os recursos mais usados em -o table/csv/tsv.
+// Recursos sem registro usam os campos escalares do struct, na ordem declarada.
+func init() {
+	beautiful.RegisterDefaultColumns[computeSdk.Instance]("id", "name", "status", "state", "machine_type.name", "image.name", "availability_zone", "created_at")
+	beautiful.RegisterDefaultColumns[computeSdk.Snapshot]("id", "name", "status", "state", "size", "created_at")
+	beautiful.RegisterDefaultColumns[blockstorageSdk.Volume]("id", "name", "status", "state", "size", "type.name", "availability_zone", "created_at")
+	beautiful.RegisterDefaultColumns[networkSdk.VPC]("id", "name", "status", "is_default", "created_at")
+	beautiful.RegisterDefaultColumns[kubernetesSdk.ClusterList]("id", "name", "status.state", "version", "region", "created_at")
+	beautiful.RegisterDefaultColumns[dbaasSdk.InstanceDetail]("id", "name", "status", "engine_id", "instance_type_id", "volume.size", "availability_zone", "created_at")
+	beautiful.RegisterDefaultColumns[containerregistrySdk.RegistryResponse]("id", "name", "storage_usage_bytes", "created_at")
+}
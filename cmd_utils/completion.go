@@ -0,0 +1,258 @@
+package cmdutils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/magaluCloud/mgccli/beautiful"
+	"github.com/magaluCloud/mgccli/cmd/common/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	completionTTL     = 2 * time.Minute
+	completionTimeout = 10 * time.Second
+	completionDir     = "cache/completion"
+)
+
+// Completion é um valor sugerido pelo shell, com a descrição exibida ao lado
+type Completion struct {
+	Value       string `json:"value"`
+	Description string `json:"description,omitempty"`
+}
+
+// CompletionSource descreve de onde vêm os valores de uma flag: Fetch chama
+// o serviço de listagem do SDK e Field indica o campo de cada item usado
+// como valor (id, name...). Resource identifica o cache (ex: compute.instances)
+type CompletionSource struct {
+	Resource string
+	Field    string
+	Fetch    func(ctx context.Context) (any, error)
+}
+
+type completionCache struct {
+	FetchedAt time.Time    `json:"fetched_at"`
+	Items     []Completion `json:"items"`
+}
+
+// CompleteFlag registra a completion da flag em parent e em todos os seus
+// subcomandos que a possuem, inclusive no argumento posicional equivalente
+// (ex: "delete [id]" completa o primeiro argumento e --id)
+func CompleteFlag(parent *cobra.Command, flag string, source CompletionSource) {
+	if parent == nil {
+		return
+	}
+	complete := source.complete
+	for _, cmd := range commandTree(parent) {
+		if cmd.Flags().Lookup(flag) == nil {
+			continue
+		}
+		_ = cmd.RegisterFlagCompletionFunc(flag, complete)
+
+		position := argPosition(cmd, flag)
+		if position < 0 {
+			continue
+		}
+		previous := cmd.ValidArgsFunction
+		cmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) == position {
+				return complete(cmd, args, toComplete)
+			}
+			if previous != nil {
+				return previous(cmd, args, toComplete)
+			}
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+	}
+}
+
+// FindCommand retorna o comando no caminho informado (ex: "network", "vpcs")
+func FindCommand(root *cobra.Command, path ...string) *cobra.Command {
+	cmd, _, err := root.Find(path)
+	if err != nil || cmd == root {
+		return nil
+	}
+	return cmd
+}
+
+func (s CompletionSource) complete(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	items, err := s.items(cmd)
+	if err != nil {
+		cobra.CompDebugln(fmt.Sprintf("completion of %s failed: %s", s.Resource, err), true)
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	suggestions := []string{}
+	for _, item := range items {
+		if !strings.HasPrefix(item.Value, toComplete) {
+			continue
+		}
+		if item.Description != "" {
+			suggestions = append(suggestions, item.Value+"\t"+item.Description)
+		} else {
+			suggestions = append(suggestions, item.Value)
+		}
+	}
+	return suggestions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// items retorna os valores do cache quando ainda válidos; senão consulta a
+// API e atualiza o cache
+func (s CompletionSource) items(cmd *cobra.Command) ([]Completion, error) {
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	path := s.cachePath(ctx)
+	if path != "" {
+		if cached, ok := readCompletionCache(path); ok {
+			return cached, nil
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, completionTimeout)
+	defer cancel()
+	list, err := s.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]Completion, 0, len(list))
+	for _, obj := range list {
+		value := fieldString(obj, s.Field)
+		if value == "" {
+			continue
+		}
+		items = append(items, Completion{Value: value, Description: completionDescription(obj, s.Field)})
+	}
+
+	if path != "" {
+		writeCompletionCache(path, items)
+	}
+	return items, nil
+}
+
+// list chama a API e retorna os itens da listagem como mapas de campos JSON
+func (s CompletionSource) list(ctx context.Context) ([]map[string]any, error) {
+	data, err := s.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := beautiful.ListItems(data)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]map[string]any, 0, len(entries))
+	for _, entry := range entries {
+		if obj, ok := entry.(map[string]any); ok {
+			list = append(list, obj)
+		}
+	}
+	return list, nil
+}
+
+// cachePath separa o cache por workspace e região, já que os recursos de
+// cada região são diferentes
+func (s CompletionSource) cachePath(ctx context.Context) string {
+	ws, ok := ctx.Value(CXT_WORKSPACE_KEY).(workspace.Workspace)
+	if !ok {
+		return ""
+	}
+	region := defaultRegion
+	if endpoint, ok := EndpointFromContext(ctx); ok && endpoint.Region != "" {
+		region = endpoint.Region
+	}
+	return filepath.Join(ws.Dir(), completionDir, fmt.Sprintf("%s_%s_%s.json", region, s.Resource, s.Field))
+}
+
+func readCompletionCache(path string) ([]Completion, bool) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	var cache completionCache
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return nil, false
+	}
+	if time.Since(cache.FetchedAt) > completionTTL {
+		return nil, false
+	}
+	return cache.Items, true
+}
+
+// writeCompletionCache grava o cache em um arquivo temporário renomeado ao
+// final, para que completions simultâneas não leiam um arquivo pela metade.
+// Falhas são ignoradas: o cache apenas acelera a completion
+func writeCompletionCache(path string, items []Completion) {
+	data, err := json.Marshal(completionCache{FetchedAt: time.Now(), Items: items})
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return
+	}
+	if err := tmp.Close(); err != nil {
+		return
+	}
+	_ = os.Rename(tmp.Name(), path)
+}
+
+// completionDescription usa o nome do recurso como descrição ou, quando o
+// nome já é o valor completado, a descrição ou o id
+func completionDescription(obj map[string]any, field string) string {
+	for _, key := range []string{"name", "description", "id"} {
+		if key == field {
+			continue
+		}
+		if value := fieldString(obj, key); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+func fieldString(obj map[string]any, field string) string {
+	switch value := obj[field].(type) {
+	case string:
+		return value
+	case float64:
+		return fmt.Sprint(value)
+	}
+	return ""
+}
+
+// argPosition retorna a posição do argumento equivalente à flag no Use dos
+// comandos gerados (ex: "attach [port-id] [security-group-id]"), ou -1
+func argPosition(cmd *cobra.Command, flag string) int {
+	fields := strings.Fields(cmd.Use)
+	for i, field := range fields[1:] {
+		if strings.Trim(field, "[]<>") == flag {
+			return i
+		}
+	}
+	return -1
+}
+
+func commandTree(cmd *cobra.Command) []*cobra.Command {
+	commands := []*cobra.Command{cmd}
+	for _, child := range cmd.Commands() {
+		commands = append(commands, commandTree(child)...)
+	}
+	return commands
+}
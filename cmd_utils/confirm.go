@@ -0,0 +1,124 @@
+package cmdutils
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+	"github.com/magaluCloud/mgccli/cmd/common/config"
+	"github.com/mattn/go-isatty"
+	"github.com/spf13/cobra"
+)
+
+const NoConfirmFlag = "no-confirm"
+
+var ErrConfirmationRequired = errors.New("confirmation required but the session is not interactive")
+
+// Confirm pede ao usuário que confirme uma ação. Retorna true sem perguntar
+// quando a confirmação foi dispensada (--no-confirm, MGC_NO_CONFIRM ou
+// config no_confirm) e falha em sessões não interativas
+func Confirm(cmd *cobra.Command, message string) (bool, error) {
+	if SkipConfirmation(cmd) {
+		return true, nil
+	}
+	if !IsInteractive() {
+		return false, confirmationRequiredError()
+	}
+
+	var confirm bool
+	err := runPrompt(huh.NewConfirm().Title(message).Affirmative("Yes").Negative("No").Value(&confirm))
+	if err != nil {
+		return false, err
+	}
+	if !confirm {
+		fmt.Fprintln(os.Stderr, "Operation cancelled")
+	}
+	return confirm, nil
+}
+
+// ConfirmByTyping é usado em operações perigosas: o usuário precisa digitar
+// o identificador do recurso para confirmar
+func ConfirmByTyping(cmd *cobra.Command, message string, expected string) (bool, error) {
+	if SkipConfirmation(cmd) {
+		return true, nil
+	}
+	if !IsInteractive() {
+		return false, confirmationRequiredError()
+	}
+
+	var typed string
+	err := runPrompt(huh.NewInput().
+		Title(message).
+		Description(fmt.Sprintf("Type %q to confirm", expected)).
+		Value(&typed))
+	if err != nil {
+		return false, err
+	}
+	if strings.TrimSpace(typed) != expected {
+		fmt.Fprintln(os.Stderr, "Operation cancelled: confirmation text does not match")
+		return false, nil
+	}
+	return true, nil
+}
+
+// PromptSecret pede um valor sem exibi-lo no terminal (ex: senhas)
+func PromptSecret(message string) (string, error) {
+	if !IsInteractive() {
+		return "", NewCliError("cannot prompt for a secret: the session is not interactive")
+	}
+
+	var secret string
+	err := runPrompt(huh.NewInput().Title(message).EchoMode(huh.EchoModePassword).Value(&secret))
+	if err != nil {
+		return "", err
+	}
+	return secret, nil
+}
+
+// SkipConfirmation informa se as confirmações foram dispensadas pela flag ou
+// pela config no_confirm, que já inclui MGC_NO_CONFIRM
+func SkipConfirmation(cmd *cobra.Command) bool {
+	if flag := cmd.Root().PersistentFlags().Lookup(NoConfirmFlag); flag != nil && flag.Changed {
+		skip, _ := cmd.Root().PersistentFlags().GetBool(NoConfirmFlag)
+		return skip
+	}
+
+	if ctx := cmd.Context(); ctx != nil {
+		if cfg, ok := ctx.Value(CXT_CONFIG_KEY).(config.Config); ok {
+			if value, err := cfg.Value(CFG_NO_CONFIRM); err == nil {
+				return value.Bool()
+			}
+		}
+	}
+	return false
+}
+
+// IsInteractive informa se é possível perguntar algo ao usuário
+func IsInteractive() bool {
+	return isTerminal(os.Stdin) && isTerminal(os.Stderr)
+}
+
+func isTerminal(file *os.File) bool {
+	return isatty.IsTerminal(file.Fd()) || isatty.IsCygwinTerminal(file.Fd())
+}
+
+// runPrompt exibe o campo em stderr para não misturar o prompt com a saída do comando
+func runPrompt(field huh.Field) error {
+	err := huh.NewForm(huh.NewGroup(field)).WithShowHelp(false).WithOutput(os.Stderr).Run()
+	if errors.Is(err, huh.ErrUserAborted) {
+		return NewCliError("operation cancelled")
+	}
+	if err != nil {
+		return NewCliError(err.Error())
+	}
+	return nil
+}
+
+func confirmationRequiredError() error {
+	return NewUsageError(
+		ErrConfirmationRequired.Error(),
+		fmt.Sprintf("use --%s or set %s=true to proceed without confirmation", NoConfirmFlag, config.EnvName(CFG_NO_CONFIRM)),
+	)
+}
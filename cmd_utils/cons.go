@@ -9,13 +9,15 @@ const (
 	CXT_WORKSPACE_KEY ContextKey = "ctxWorkspace"
 	CTX_SDK_KEY       ContextKey = "ctxSdk"
 	CTX_ERROR_HANDLED ContextKey = "ctxErrorHandled"
+	CTX_ENDPOINT_KEY  ContextKey = "ctxEndpoint"
 )
 
 // Environment constants
 type Env string
 
 const (
-	ENV_API_KEY Env = "CLI_API_KEY"
+	ENV_API_KEY                Env = "CLI_API_KEY"
+	ENV_CREDENTIALS_PASSPHRASE Env = "MGC_CREDENTIALS_PASSPHRASE"
 )
 
 func (e Env) String() string {
@@ -37,6 +39,7 @@ const (
 	CFG_LANG               = "lang"
 	CFG_SERVER_URL         = "server_url"
 	CFG_VERSION_LAST_CHECK = "version_last_check"
+	CFG_CREDENTIAL_STORE   = "credential_store"
 )
 
 func (c ConfigKey) String() string {
@@ -0,0 +1,44 @@
+package cmdutils
+
+import (
+	"github.com/magaluCloud/mgccli/beautiful"
+	"github.com/spf13/cobra"
+)
+
+const DryRunFlag = "dry-run"
+
+// DryRunRequest descreve a chamada que seria feita ao SDK
+type DryRunRequest struct {
+	Command   string         `json:"command"`
+	Operation string         `json:"operation"`
+	Params    map[string]any `json:"params,omitempty"`
+	Body      any            `json:"body,omitempty"`
+}
+
+// DryRun é chamado pelos comandos que alteram recursos logo antes da chamada
+// ao SDK. Com --dry-run imprime a operação, os parâmetros e o corpo da
+// requisição no formato de saída escolhido e retorna true, indicando que o
+// comando deve terminar sem chamar a API
+func DryRun(cmd *cobra.Command, operation string, params map[string]any, body map[string]any) bool {
+	dryRun, err := cmd.Root().PersistentFlags().GetBool(DryRunFlag)
+	if err != nil || !dryRun {
+		return false
+	}
+
+	request := DryRunRequest{
+		Command:   cmd.CommandPath(),
+		Operation: operation,
+		Params:    params,
+	}
+	if len(body) == 1 {
+		for _, value := range body {
+			request.Body = value
+		}
+	} else if len(body) > 1 {
+		request.Body = body
+	}
+
+	raw, _ := cmd.Root().PersistentFlags().GetBool("raw")
+	beautiful.NewOutput(raw).PrintData(request)
+	return true
+}
@@ -0,0 +1,95 @@
+package cmdutils
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	sdk "github.com/MagaluCloud/mgc-sdk-go/client"
+	"github.com/magaluCloud/mgccli/cmd/common/config"
+)
+
+const (
+	RegionFlag = "region"
+
+	EnvProd    = "prod"
+	EnvPreProd = "pre-prod"
+
+	defaultRegion = "br-se1"
+)
+
+// Regions lista as regiões aceitas por --region, MGC_REGION e pela config
+// region, definidas no validator da config
+func Regions() []string {
+	return config.Options(CFG_REGION)
+}
+
+var serverURLs = map[string]string{
+	EnvProd:    "https://api.magalu.cloud",
+	EnvPreProd: "https://api.pre-prod.jaxyendy.com",
+}
+
+// Endpoint identifica para onde as chamadas da API são enviadas
+type Endpoint struct {
+	Region    string
+	Env       string
+	ServerURL string
+}
+
+// BaseURL retorna a URL dos produtos regionais (ex: https://api.magalu.cloud/br-ne1)
+func (e Endpoint) BaseURL() sdk.MgcUrl {
+	return sdk.MgcUrl(e.GlobalURL().String() + "/" + e.Region)
+}
+
+// GlobalURL retorna a URL dos produtos que não dependem de região
+func (e Endpoint) GlobalURL() sdk.MgcUrl {
+	return sdk.MgcUrl(strings.TrimSuffix(e.ServerURL, "/"))
+}
+
+// ResolveEndpoint resolve região, ambiente e servidor da API a partir das
+// configs, que já consideram flag > variável de ambiente > projeto > workspace
+func ResolveEndpoint(cfg config.Config) (Endpoint, error) {
+	endpoint := Endpoint{
+		Region: defaultRegion,
+		Env:    EnvProd,
+	}
+
+	if value, err := cfg.Value(CFG_REGION); err == nil && value.String() != "" {
+		endpoint.Region = value.String()
+	}
+
+	if value, err := cfg.Value(CFG_ENV); err == nil && value.String() != "" {
+		endpoint.Env = value.String()
+	}
+
+	if value, err := cfg.Value(CFG_SERVER_URL); err == nil {
+		endpoint.ServerURL = value.String()
+	}
+
+	if !slices.Contains(Regions(), endpoint.Region) {
+		return endpoint, NewUsageError(
+			fmt.Sprintf("invalid region %q", endpoint.Region),
+			fmt.Sprintf("available regions: %s", strings.Join(Regions(), ", ")),
+		)
+	}
+
+	serverURL, ok := serverURLs[endpoint.Env]
+	if !ok {
+		return endpoint, NewUsageError(
+			fmt.Sprintf("invalid env %q", endpoint.Env),
+			fmt.Sprintf("available envs: %s, %s", EnvProd, EnvPreProd),
+		)
+	}
+	if endpoint.ServerURL == "" {
+		endpoint.ServerURL = serverURL
+	}
+
+	return endpoint, nil
+}
+
+// EndpointFromContext retorna o endpoint resolvido na inicialização da CLI
+func EndpointFromContext(ctx context.Context) (Endpoint, bool) {
+	endpoint, ok := ctx.Value(CTX_ENDPOINT_KEY).(Endpoint)
+	return endpoint, ok
+}
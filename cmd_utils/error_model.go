@@ -0,0 +1,170 @@
+package cmdutils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+
+	clientSDK "github.com/MagaluCloud/mgc-sdk-go/client"
+	"github.com/spf13/cobra"
+)
+
+// ErrorKind classifica os erros da CLI. Cada categoria tem um código de saída estável
+type ErrorKind string
+
+const (
+	ErrorKindGeneric     ErrorKind = "error"
+	ErrorKindUsage       ErrorKind = "usage"
+	ErrorKindAuth        ErrorKind = "auth"
+	ErrorKindNotFound    ErrorKind = "not_found"
+	ErrorKindConflict    ErrorKind = "conflict"
+	ErrorKindValidation  ErrorKind = "validation"
+	ErrorKindRateLimited ErrorKind = "rate_limited"
+	ErrorKindServer      ErrorKind = "server"
+	ErrorKindTimeout     ErrorKind = "timeout"
+)
+
+// Códigos de saída da CLI. Os valores fazem parte do contrato com scripts e
+// automações e não devem ser alterados
+const (
+	ExitOK          = 0
+	ExitError       = 1
+	ExitUsage       = 2
+	ExitAuth        = 3
+	ExitNotFound    = 4
+	ExitConflict    = 5
+	ExitValidation  = 6
+	ExitRateLimited = 7
+	ExitServer      = 8
+	ExitTimeout     = 9
+)
+
+var exitCodes = map[ErrorKind]int{
+	ErrorKindGeneric:     ExitError,
+	ErrorKindUsage:       ExitUsage,
+	ErrorKindAuth:        ExitAuth,
+	ErrorKindNotFound:    ExitNotFound,
+	ErrorKindConflict:    ExitConflict,
+	ErrorKindValidation:  ExitValidation,
+	ErrorKindRateLimited: ExitRateLimited,
+	ErrorKindServer:      ExitServer,
+	ErrorKindTimeout:     ExitTimeout,
+}
+
+// ExitCode retorna o código de saída da categoria
+func (k ErrorKind) ExitCode() int {
+	if code, ok := exitCodes[k]; ok {
+		return code
+	}
+	return ExitError
+}
+
+// ErrorModel é a representação estruturada de um erro, escrita em stderr
+// quando a saída é JSON ou raw
+type ErrorModel struct {
+	Kind       ErrorKind `json:"kind"`
+	Message    string    `json:"message"`
+	Details    string    `json:"details,omitempty"`
+	Status     int       `json:"status,omitempty"`
+	Body       any       `json:"body,omitempty"`
+	URL        string    `json:"url,omitempty"`
+	RequestID  string    `json:"request_id,omitempty"`
+	MgcTraceID string    `json:"mgc_trace_id,omitempty"`
+	Retries    int       `json:"retries,omitempty"`
+	ExitCode   int       `json:"exit_code"`
+}
+
+// NewErrorModel classifica o erro e extrai os dados da resposta HTTP, quando houver
+func NewErrorModel(err error) ErrorModel {
+	msg, detail := ParseSDKError(err)
+	model := ErrorModel{
+		Kind:    ErrorKindGeneric,
+		Message: msg,
+		Details: strings.TrimSpace(detail),
+	}
+
+	var retryErr *clientSDK.RetryError
+	if errors.As(err, &retryErr) && retryErr != nil {
+		model.Retries = retryErr.Retries
+		if retryErr.LastError != nil {
+			err = retryErr.LastError
+		}
+	}
+
+	var cliErr *CliError
+	var httpErr *clientSDK.HTTPError
+	var validationErr *clientSDK.ValidationError
+	var netErr net.Error
+	switch {
+	case errors.As(err, &cliErr) && cliErr.Kind != "":
+		model.Kind = cliErr.Kind
+	case errors.As(err, &httpErr) && httpErr != nil:
+		model.Kind = kindFromStatus(httpErr.StatusCode)
+		// os campos estruturados substituem o texto formatado da resposta
+		model.Details = ""
+		model.Status = httpErr.StatusCode
+		model.Body = parseBody(httpErr.Body)
+		if response, buildErr := buildFromSDKError(httpErr); buildErr == nil {
+			model.URL = response.URL
+			model.RequestID = response.RequestID
+			model.MgcTraceID = response.MgcTraceID
+		}
+	case errors.As(err, &validationErr):
+		model.Kind = ErrorKindValidation
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+		model.Kind = ErrorKindTimeout
+	}
+
+	model.ExitCode = model.Kind.ExitCode()
+	return model
+}
+
+// ExitCode retorna o código de saída para o erro retornado pela execução de
+// cmd. Erros que não passaram pelo tratamento dos comandos vêm do próprio
+// cobra (comando desconhecido, argumentos ou flags inválidos)
+func ExitCode(cmd *cobra.Command, err error) int {
+	if err == nil {
+		return ExitOK
+	}
+	if cmd != nil && cmd.Context() != nil {
+		if handled, ok := cmd.Context().Value(CTX_ERROR_HANDLED).(bool); !ok || !handled {
+			return ExitUsage
+		}
+	}
+	return NewErrorModel(err).ExitCode
+}
+
+func kindFromStatus(status int) ErrorKind {
+	switch {
+	case status == http.StatusUnauthorized, status == http.StatusForbidden:
+		return ErrorKindAuth
+	case status == http.StatusNotFound:
+		return ErrorKindNotFound
+	case status == http.StatusConflict:
+		return ErrorKindConflict
+	case status == http.StatusBadRequest, status == http.StatusUnprocessableEntity:
+		return ErrorKindValidation
+	case status == http.StatusTooManyRequests:
+		return ErrorKindRateLimited
+	case status == http.StatusRequestTimeout, status == http.StatusGatewayTimeout:
+		return ErrorKindTimeout
+	case status >= 500:
+		return ErrorKindServer
+	}
+	return ErrorKindGeneric
+}
+
+// parseBody devolve o corpo como JSON quando possível, senão como texto
+func parseBody(body []byte) any {
+	if len(body) == 0 {
+		return nil
+	}
+	var parsed any
+	if err := json.Unmarshal(body, &parsed); err == nil {
+		return parsed
+	}
+	return string(body)
+}
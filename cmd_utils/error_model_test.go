@@ -0,0 +1,30 @@
+package cmdutils
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestNewErrorModelKinds(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		kind ErrorKind
+		exit int
+	}{
+		{name: "missing argument", err: NewMissingArgError("id"), kind: ErrorKindUsage, exit: ExitUsage},
+		{name: "wrapped missing argument", err: fmt.Errorf("get: %w", NewMissingArgError("id")), kind: ErrorKindUsage, exit: ExitUsage},
+		{name: "same text without type", err: errors.New("é necessário fornecer o id como argumento ou usar a flag --id"), kind: ErrorKindGeneric, exit: ExitError},
+		{name: "timeout", err: &CliError{Message: "timed out", Kind: ErrorKindTimeout}, kind: ErrorKindTimeout, exit: ExitTimeout},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := NewErrorModel(tt.err)
+			if model.Kind != tt.kind || model.ExitCode != tt.exit {
+				t.Errorf("got kind %s and exit code %d, want %s and %d", model.Kind, model.ExitCode, tt.kind, tt.exit)
+			}
+		})
+	}
+}
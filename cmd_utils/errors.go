@@ -10,6 +10,7 @@ import (
 type CliError struct {
 	Message string
 	Details string
+	Kind    ErrorKind
 }
 
 func (e *CliError) Error() string {
@@ -36,6 +37,21 @@ func NewCliErrorWithDetails(message, details string) *CliError {
 	}
 }
 
+// NewUsageError cria um erro de uso incorreto da CLI (flags, argumentos ou valores inválidos)
+func NewUsageError(message, details string) *CliError {
+	return &CliError{
+		Message: message,
+		Details: details,
+		Kind:    ErrorKindUsage,
+	}
+}
+
+// NewMissingArgError cria o erro de uso dos comandos quando um valor
+// obrigatório não é informado nem como argumento nem como flag
+func NewMissingArgError(name string) *CliError {
+	return NewUsageError(fmt.Sprintf("é necessário fornecer o %s como argumento ou usar a flag --%s", name, name), "")
+}
+
 const (
 	simpleHttpError       = "API request failed with HTTP error"
 	simpleValidationError = "Request validation failed"
@@ -1,13 +1,34 @@
 package cmdutils
 
-import "net/http"
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+)
 
 type Transport struct {
 	Headers map[string]string
 	Base    http.RoundTripper
+	// Trace registra cada requisição e resposta no Logger, em nível debug
+	Trace  bool
+	Logger *slog.Logger
+	// Token fornece o access token no momento da requisição, assim as
+	// credenciais só são lidas pelos comandos que chamam a API
+	Token func(ctx context.Context) string
+	// Endpoint é o endpoint usado pelo SDK. Requisições com outra região no
+	// contexto (WithRegion) são enviadas à URL base dessa região
+	Endpoint Endpoint
 }
 
 func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.Token != nil && req.Header.Get("Authorization") == "" {
+		if token := t.Token(req.Context()); token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+	}
 	for k, v := range t.Headers {
 		req.Header.Add(k, v)
 	}
@@ -20,9 +41,51 @@ func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
 	}
 	req.Header = httpHeaders
 
+	if region, ok := RegionFromContext(req.Context()); ok {
+		regional, err := t.regionURL(req.URL, region)
+		if err != nil {
+			return nil, err
+		}
+		req = req.Clone(req.Context())
+		req.URL = regional
+	}
+
 	Base := t.Base
 	if Base == nil {
 		Base = http.DefaultTransport
 	}
+	if t.Trace && t.Logger != nil {
+		return t.trace(Base, req)
+	}
 	return Base.RoundTrip(req)
 }
+
+// regionURL troca a URL base do Endpoint na URL da requisição pela URL base
+// da região (ex: https://proxy/api/br-se1/compute -> https://proxy/api/br-ne1/compute).
+// Requisições fora da URL base regional são recusadas, para que a mesma
+// resposta não seja atribuída a regiões diferentes
+func (t *Transport) regionURL(requestURL *url.URL, region string) (*url.URL, error) {
+	target := t.Endpoint
+	target.Region = region
+	current, err := url.Parse(string(t.Endpoint.BaseURL()))
+	if err != nil {
+		return nil, err
+	}
+	regional, err := url.Parse(string(target.BaseURL()))
+	if err != nil {
+		return nil, err
+	}
+
+	rest, ok := strings.CutPrefix(requestURL.Path, current.Path)
+	ok = ok && (rest == "" || strings.HasPrefix(rest, "/"))
+	if !ok || t.Endpoint.Region == "" || requestURL.Scheme != current.Scheme || requestURL.Host != current.Host {
+		return nil, NewCliError(fmt.Sprintf("cannot send %s to region %s: the URL is outside the regional API %s", requestURL.Redacted(), region, current.Redacted()))
+	}
+
+	result := *requestURL
+	result.Scheme = regional.Scheme
+	result.Host = regional.Host
+	result.Path = regional.Path + rest
+	result.RawPath = ""
+	return &result, nil
+}
@@ -0,0 +1,161 @@
+package cmdutils
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	redacted         = "REDACTED"
+	maxTracedBodyLen = 64 * 1024
+)
+
+var redactedHeaders = []string{"Authorization", "X-Api-Key", "Cookie", "Set-Cookie", "Proxy-Authorization"}
+
+// sensitiveKeys identifica campos de corpo e query que nunca são registrados
+var sensitiveKeys = []string{"password", "secret", "token", "private_key", "api_key", "apikey"}
+
+// trace executa a requisição registrando método, URL, status, latência,
+// headers e corpos, com credenciais e segredos mascarados
+func (t *Transport) trace(base http.RoundTripper, req *http.Request) (*http.Response, error) {
+	reqBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+	t.Logger.Debug("http request",
+		"method", req.Method,
+		"url", redactURL(req.URL),
+		"headers", redactHeaders(req.Header),
+		"body", redactBody(reqBody, req.Header.Get("Content-Type")),
+	)
+
+	start := time.Now()
+	resp, err := base.RoundTrip(req)
+	latency := time.Since(start)
+	if err != nil {
+		t.Logger.Debug("http error",
+			"method", req.Method,
+			"url", redactURL(req.URL),
+			"latency", latency.String(),
+			"error", err.Error(),
+		)
+		return resp, err
+	}
+
+	respBody, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	t.Logger.Debug("http response",
+		"method", req.Method,
+		"url", redactURL(req.URL),
+		"status", resp.StatusCode,
+		"latency", latency.String(),
+		"headers", redactHeaders(resp.Header),
+		"body", redactBody(respBody, resp.Header.Get("Content-Type")),
+	)
+	return resp, nil
+}
+
+// readBody lê o corpo e o substitui por uma cópia para que continue disponível
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+	data, err := io.ReadAll(*body)
+	(*body).Close()
+	if err != nil {
+		return nil, err
+	}
+	*body = io.NopCloser(bytes.NewReader(data))
+	return data, nil
+}
+
+func redactHeaders(headers http.Header) map[string]string {
+	result := make(map[string]string, len(headers))
+	for key, values := range headers {
+		value := strings.Join(values, ", ")
+		for _, sensitive := range redactedHeaders {
+			if strings.EqualFold(key, sensitive) {
+				value = redacted
+			}
+		}
+		result[key] = value
+	}
+	return result
+}
+
+func redactURL(u *url.URL) string {
+	if u.RawQuery == "" {
+		return u.String()
+	}
+	copied := *u
+	copied.RawQuery = redactValues(u.Query()).Encode()
+	return copied.String()
+}
+
+func redactValues(values url.Values) url.Values {
+	for key := range values {
+		if isSensitiveKey(key) {
+			values.Set(key, redacted)
+		}
+	}
+	return values
+}
+
+func redactBody(body []byte, contentType string) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	if strings.Contains(contentType, "application/x-www-form-urlencoded") {
+		if values, err := url.ParseQuery(string(body)); err == nil {
+			return redactValues(values).Encode()
+		}
+	}
+
+	var generic any
+	if err := json.Unmarshal(body, &generic); err == nil {
+		if data, err := json.Marshal(redactJSON(generic)); err == nil {
+			body = data
+		}
+	}
+
+	if len(body) > maxTracedBodyLen {
+		return string(body[:maxTracedBodyLen]) + "...(truncated)"
+	}
+	return string(body)
+}
+
+func redactJSON(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			if isSensitiveKey(key) {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redactJSON(item)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = redactJSON(item)
+		}
+	}
+	return value
+}
+
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, sensitive := range sensitiveKeys {
+		if strings.Contains(key, sensitive) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,195 @@
+package cmdutils
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/magaluCloud/mgccli/beautiful"
+	"github.com/spf13/cobra"
+)
+
+const (
+	PaginationAllFlag      = "all"
+	PaginationPageSizeFlag = "page-size"
+	PaginationMaxItemsFlag = "max-items"
+
+	defaultPageSize = 100
+)
+
+// AddPaginationFlags adiciona --all, --page-size e --max-items a um comando de listagem
+func AddPaginationFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(PaginationAllFlag, false, "Fetch all pages, walking offset/limit automatically")
+	cmd.Flags().Int(PaginationPageSizeFlag, defaultPageSize, "Number of items requested per page when --all is used")
+	cmd.Flags().Int(PaginationMaxItemsFlag, 0, "Maximum number of items returned when --all is used (0 means no limit)")
+}
+
+// ListAll executa a listagem. Sem --all faz uma única chamada, respeitando
+// --limit/--offset. Com --all percorre as páginas ajustando offset e limit
+// e junta os itens em uma única resposta. Quando o formato de saída permite
+// (jsonl), cada página é impressa assim que chega e streamed retorna true.
+// Com --all-regions a listagem é repetida em cada região (veja ListRegions)
+func ListAll(ctx context.Context, cmd *cobra.Command, offset **int, limit **int, fetch func(ctx context.Context) (any, error)) (result any, streamed bool, err error) {
+	all, _ := cmd.Flags().GetBool(PaginationAllFlag)
+
+	if allRegions(cmd) {
+		if !all {
+			result, err = fanOutRegions(ctx, cmd, workers(cmd), fetch)
+			return result, false, err
+		}
+		// offset e limit são compartilhados, então as regiões são paginadas uma por vez
+		initialOffset := *offset
+		result, err = fanOutRegions(ctx, cmd, 1, func(ctx context.Context) (any, error) {
+			*offset = initialOffset
+			pages, _, err := listPages(ctx, cmd, offset, limit, fetch, false)
+			return pages, err
+		})
+		return result, false, err
+	}
+
+	if !all {
+		result, err = fetch(ctx)
+		return result, false, err
+	}
+
+	stream := beautiful.DefaultFormat() == beautiful.FormatJSONLines && !beautiful.HasQuery()
+	return listPages(ctx, cmd, offset, limit, fetch, stream)
+}
+
+// listPages percorre as páginas a partir do offset informado
+func listPages(ctx context.Context, cmd *cobra.Command, offset **int, limit **int, fetch func(ctx context.Context) (any, error), stream bool) (result any, streamed bool, err error) {
+	pageSize, _ := cmd.Flags().GetInt(PaginationPageSizeFlag)
+	if pageSize <= 0 {
+		return nil, false, NewUsageError(fmt.Sprintf("--%s must be greater than zero", PaginationPageSizeFlag), "")
+	}
+	maxItems, _ := cmd.Flags().GetInt(PaginationMaxItemsFlag)
+
+	var printPage func(page any)
+	if stream {
+		raw, _ := cmd.Root().PersistentFlags().GetBool("raw")
+		printPage = beautiful.NewOutput(raw).PrintData
+	}
+	return walkPages(ctx, offset, limit, pageSize, maxItems, fetch, printPage)
+}
+
+// FetchAll percorre todas as páginas de uma listagem que não tem flags de
+// paginação, como as fontes de completion e de resolução de nomes
+func FetchAll(ctx context.Context, offset **int, limit **int, fetch func(ctx context.Context) (any, error)) (any, error) {
+	result, _, err := walkPages(ctx, offset, limit, defaultPageSize, 0, fetch, nil)
+	return result, err
+}
+
+// walkPages busca as páginas ajustando offset e limit e junta os itens em
+// uma única resposta. Com printPage, cada página é impressa e não é acumulada
+func walkPages(ctx context.Context, offset **int, limit **int, pageSize int, maxItems int, fetch func(ctx context.Context) (any, error), printPage func(page any)) (result any, streamed bool, err error) {
+	currentOffset := 0
+	if *offset != nil {
+		currentOffset = **offset
+	}
+
+	total := 0
+	for {
+		if maxItems > 0 && pageSize > maxItems-total {
+			pageSize = maxItems - total
+		}
+		pageOffset, pageLimit := currentOffset, pageSize
+		*offset = &pageOffset
+		*limit = &pageLimit
+
+		page, err := fetch(ctx)
+		if err != nil {
+			return result, streamed, err
+		}
+
+		items, err := pageItems(page)
+		if err != nil {
+			return nil, false, err
+		}
+		if maxItems > 0 && total+items.Len() > maxItems {
+			items = items.Slice(0, maxItems-total)
+			if page, err = setItems(page, items); err != nil {
+				return nil, false, err
+			}
+		}
+		count := items.Len()
+
+		switch {
+		case printPage != nil:
+			printPage(page)
+			streamed = true
+		case result == nil:
+			result = page
+		default:
+			merged, err := pageItems(result)
+			if err != nil {
+				return nil, false, err
+			}
+			if result, err = setItems(result, reflect.AppendSlice(merged, items)); err != nil {
+				return nil, false, err
+			}
+		}
+
+		total += count
+		currentOffset += count
+		// uma página menor que o limit não indica o fim: a API pode limitar o
+		// tamanho das páginas abaixo de --page-size. Só a página vazia encerra
+		if count == 0 || (maxItems > 0 && total >= maxItems) {
+			return result, streamed, nil
+		}
+	}
+}
+
+// pageItems localiza a lista de itens de uma página: a própria resposta,
+// quando é um slice, ou o único campo do tipo slice de um struct
+func pageItems(page any) (reflect.Value, error) {
+	value := reflect.ValueOf(page)
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return reflect.Value{}, NewCliError("cannot paginate a nil response")
+		}
+		value = value.Elem()
+	}
+
+	switch value.Kind() {
+	case reflect.Slice:
+		return value, nil
+	case reflect.Struct:
+		var found reflect.Value
+		count := 0
+		for i := 0; i < value.NumField(); i++ {
+			if value.Type().Field(i).IsExported() && value.Field(i).Kind() == reflect.Slice {
+				found = value.Field(i)
+				count++
+			}
+		}
+		if count == 1 {
+			return found, nil
+		}
+	}
+
+	return reflect.Value{}, NewCliError(fmt.Sprintf("cannot paginate response of type %T", page))
+}
+
+// setItems troca a lista de itens da página, devolvendo a página atualizada
+func setItems(page any, items reflect.Value) (any, error) {
+	value := reflect.ValueOf(page)
+	switch value.Kind() {
+	case reflect.Slice:
+		return items.Interface(), nil
+	case reflect.Struct:
+		// structs retornados por valor são copiados para poderem ser alterados
+		copied := reflect.New(value.Type())
+		copied.Elem().Set(value)
+		if _, err := setItems(copied.Interface(), items); err != nil {
+			return nil, err
+		}
+		return copied.Elem().Interface(), nil
+	}
+
+	target, err := pageItems(page)
+	if err != nil {
+		return nil, err
+	}
+	target.Set(items)
+	return page, nil
+}
@@ -0,0 +1,123 @@
+package cmdutils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+type fakeItem struct {
+	ID int `json:"id"`
+}
+
+type fakeListResponse struct {
+	Items []fakeItem `json:"items"`
+}
+
+// fakeSource simula uma API com total itens que devolve no máximo maxPage
+// itens por página, independente do limit pedido
+type fakeSource struct {
+	total   int
+	maxPage int
+	calls   int
+}
+
+func (s *fakeSource) list(offset *int, limit *int) fakeListResponse {
+	s.calls++
+	start := 0
+	if offset != nil {
+		start = *offset
+	}
+	size := s.maxPage
+	if limit != nil && *limit < size {
+		size = *limit
+	}
+	response := fakeListResponse{Items: []fakeItem{}}
+	for i := start; i < start+size && i < s.total; i++ {
+		response.Items = append(response.Items, fakeItem{ID: i})
+	}
+	return response
+}
+
+func newPaginationCmd(t *testing.T, args ...string) *cobra.Command {
+	t.Helper()
+	cmd := &cobra.Command{Use: "list"}
+	AddPaginationFlags(cmd)
+	if err := cmd.ParseFlags(args); err != nil {
+		t.Fatal(err)
+	}
+	return cmd
+}
+
+func TestListAll(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          []string
+		total         int
+		maxPage       int
+		expectedItems int
+	}{
+		{name: "single page without --all", args: nil, total: 250, maxPage: 30, expectedItems: 30},
+		{name: "pages capped below --page-size", args: []string{"--all"}, total: 250, maxPage: 30, expectedItems: 250},
+		{name: "pages of --page-size", args: []string{"--all", "--page-size", "40"}, total: 250, maxPage: 100, expectedItems: 250},
+		{name: "exact multiple of the page size", args: []string{"--all", "--page-size", "50"}, total: 100, maxPage: 100, expectedItems: 100},
+		{name: "max items", args: []string{"--all", "--max-items", "75"}, total: 250, maxPage: 30, expectedItems: 75},
+		{name: "empty", args: []string{"--all"}, total: 0, maxPage: 30, expectedItems: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := &fakeSource{total: tt.total, maxPage: tt.maxPage}
+			var offset, limit *int
+			result, streamed, err := ListAll(context.Background(), newPaginationCmd(t, tt.args...), &offset, &limit, func(ctx context.Context) (any, error) {
+				return source.list(offset, limit), nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if streamed {
+				t.Fatal("result was streamed")
+			}
+
+			items := result.(fakeListResponse).Items
+			if len(items) != tt.expectedItems {
+				t.Fatalf("got %d items, want %d", len(items), tt.expectedItems)
+			}
+			for i, item := range items {
+				if item.ID != i {
+					t.Fatalf("item %d has id %d", i, item.ID)
+				}
+			}
+		})
+	}
+}
+
+func TestListAllRejectsInvalidPageSize(t *testing.T) {
+	var offset, limit *int
+	_, _, err := ListAll(context.Background(), newPaginationCmd(t, "--all", "--page-size", "0"), &offset, &limit, func(ctx context.Context) (any, error) {
+		t.Fatal("fetch called with an invalid page size")
+		return nil, nil
+	})
+	if err == nil {
+		t.Fatal("expected an error for --page-size 0")
+	}
+}
+
+func TestFetchAll(t *testing.T) {
+	source := &fakeSource{total: 130, maxPage: 25}
+	var opts struct{ Offset, Limit *int }
+	result, err := FetchAll(context.Background(), &opts.Offset, &opts.Limit, func(ctx context.Context) (any, error) {
+		return source.list(opts.Offset, opts.Limit).Items, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if items := result.([]fakeItem); len(items) != 130 {
+		t.Errorf("got %d items, want 130", len(items))
+	}
+	// 6 páginas com itens e a página vazia que encerra a listagem
+	if source.calls != 7 {
+		t.Errorf("got %d calls, want 7", source.calls)
+	}
+}
@@ -0,0 +1,138 @@
+package cmdutils
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/magaluCloud/mgccli/beautiful"
+	"github.com/magaluCloud/mgccli/cmd/common/config"
+	"github.com/spf13/cobra"
+)
+
+const (
+	AllRegionsFlag = "all-regions"
+	regionField    = "region"
+	defaultWorkers = 5
+)
+
+type regionContextKey struct{}
+
+// AddAllRegionsFlag adiciona --all-regions a um comando de listagem regional
+func AddAllRegionsFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool(AllRegionsFlag, false, "List resources of every region, adding a region field to each item")
+}
+
+// WithRegion direciona as chamadas feitas com o contexto para outra região.
+// O Transport troca a URL base do Endpoint pela URL base da região
+func WithRegion(ctx context.Context, region string) context.Context {
+	return context.WithValue(ctx, regionContextKey{}, region)
+}
+
+// RegionFromContext retorna a região definida com WithRegion
+func RegionFromContext(ctx context.Context) (string, bool) {
+	region, ok := ctx.Value(regionContextKey{}).(string)
+	return region, ok && region != ""
+}
+
+func allRegions(cmd *cobra.Command) bool {
+	all, err := cmd.Flags().GetBool(AllRegionsFlag)
+	return err == nil && all
+}
+
+// ListRegions executa a listagem na região atual ou, com --all-regions, em
+// todas as regiões. Veja ListAll para listagens paginadas
+func ListRegions(ctx context.Context, cmd *cobra.Command, fetch func(ctx context.Context) (any, error)) (any, error) {
+	if !allRegions(cmd) {
+		return fetch(ctx)
+	}
+	return fanOutRegions(ctx, cmd, workers(cmd), fetch)
+}
+
+// fanOutRegions executa fetch em cada região, com no máximo workers chamadas
+// simultâneas, e junta os itens em uma única lista com o campo region, que
+// também é a primeira coluna da tabela.
+// Falhas de uma região são avisadas em stderr; o comando só falha quando
+// nenhuma região responde
+func fanOutRegions(ctx context.Context, cmd *cobra.Command, workers int, fetch func(ctx context.Context) (any, error)) (any, error) {
+	type regionResult struct {
+		data any
+		err  error
+	}
+
+	regions := Regions()
+	results := make([]regionResult, len(regions))
+	sem := make(chan struct{}, workers)
+	var wg sync.WaitGroup
+	for i, region := range regions {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			data, err := fetch(WithRegion(ctx, region))
+			results[i] = regionResult{data: data, err: err}
+		}()
+	}
+	wg.Wait()
+
+	merged := []any{}
+	failures := []string{}
+	var columns []string
+	for i, region := range regions {
+		result := results[i]
+		if result.err == nil {
+			var items []any
+			if items, result.err = beautiful.ListItems(result.data); result.err == nil {
+				merged = append(merged, withRegionField(items, region)...)
+				if columns == nil {
+					columns = beautiful.ColumnsOf(result.data)
+				}
+				continue
+			}
+		}
+
+		msg, detail := ParseSDKError(result.err)
+		if detail != "" {
+			msg = fmt.Sprintf("%s: %s", msg, detail)
+		}
+		failures = append(failures, fmt.Sprintf("%s: %s", region, msg))
+		fmt.Fprintf(os.Stderr, "Warning: failed to list resources in region %s: %s\n", region, msg)
+	}
+
+	if len(failures) == len(regions) {
+		return nil, NewCliErrorWithDetails("failed to list resources in every region", strings.Join(failures, "\n"))
+	}
+
+	if len(columns) > 0 && !slices.Contains(columns, regionField) {
+		columns = append([]string{regionField}, columns...)
+	}
+	return beautiful.Table{Items: merged, Columns: columns}, nil
+}
+
+func withRegionField(items []any, region string) []any {
+	for i, item := range items {
+		obj, ok := item.(map[string]any)
+		if !ok {
+			obj = map[string]any{"value": item}
+		}
+		obj[regionField] = region
+		items[i] = obj
+	}
+	return items
+}
+
+// workers retorna o limite de chamadas simultâneas da config workers
+func workers(cmd *cobra.Command) int {
+	if ctx := cmd.Context(); ctx != nil {
+		if cfg, ok := ctx.Value(CXT_CONFIG_KEY).(config.Config); ok {
+			if value, err := cfg.Value(CFG_WORKERS); err == nil && value.Int() > 0 {
+				return value.Int()
+			}
+		}
+	}
+	return defaultWorkers
+}
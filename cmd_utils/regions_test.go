@@ -0,0 +1,133 @@
+package cmdutils
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/magaluCloud/mgccli/beautiful"
+	"github.com/spf13/cobra"
+)
+
+type regionVolume struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type regionVolumeList struct {
+	Volumes []regionVolume `json:"volumes"`
+}
+
+func TestFanOutRegionsKeepsColumnsInResult(t *testing.T) {
+	result, err := fanOutRegions(context.Background(), &cobra.Command{}, 2, func(ctx context.Context) (any, error) {
+		region, _ := RegionFromContext(ctx)
+		if region == "br-mgl1" {
+			return nil, errors.New("unavailable")
+		}
+		return regionVolumeList{Volumes: []regionVolume{{ID: "1", Name: region}}}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if columns := beautiful.Columns(); len(columns) != 0 {
+		t.Fatalf("global columns changed to %v", columns)
+	}
+
+	table, ok := result.(beautiful.Table)
+	if !ok {
+		t.Fatalf("got %T, want beautiful.Table", result)
+	}
+	if want := []string{"region", "id", "name"}; !slices.Equal(table.Columns, want) {
+		t.Errorf("columns = %v, want %v", table.Columns, want)
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `[{"id":"1","name":"br-se1","region":"br-se1"},{"id":"1","name":"br-ne1","region":"br-ne1"}]`
+	if string(data) != want {
+		t.Errorf("json = %s, want %s", data, want)
+	}
+
+	var out bytes.Buffer
+	beautiful.NewOutput(true).WithFormat(beautiful.FormatCSV).WithWriter(&out).PrintData(result)
+	if header, _, _ := strings.Cut(out.String(), "\n"); header != "region,id,name" {
+		t.Errorf("csv header = %q, want %q", header, "region,id,name")
+	}
+}
+
+func TestFanOutRegionsFailsWhenEveryRegionFails(t *testing.T) {
+	_, err := fanOutRegions(context.Background(), &cobra.Command{}, 1, func(ctx context.Context) (any, error) {
+		return nil, errors.New("unavailable")
+	})
+	if err == nil {
+		t.Fatal("expected an error when no region responds")
+	}
+}
+
+func TestRegionsFromConfigValidator(t *testing.T) {
+	if want := []string{"br-se1", "br-ne1", "br-mgl1"}; !slices.Equal(Regions(), want) {
+		t.Errorf("Regions() = %v, want %v", Regions(), want)
+	}
+}
+
+func TestTransportSendsRequestToRegion(t *testing.T) {
+	paths := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths <- r.URL.RequestURI()
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name      string
+		serverURL string
+		path      string
+		expected  string
+	}{
+		{name: "server root", serverURL: server.URL, path: "/br-se1/compute/v1/instances?limit=1", expected: "/br-ne1/compute/v1/instances?limit=1"},
+		{name: "server with path prefix", serverURL: server.URL + "/api/", path: "/api/br-se1/compute/v1/instances", expected: "/api/br-ne1/compute/v1/instances"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transport := &Transport{Endpoint: Endpoint{Region: "br-se1", Env: EnvProd, ServerURL: tt.serverURL}}
+			client := &http.Client{Transport: transport}
+
+			req, _ := http.NewRequestWithContext(WithRegion(context.Background(), "br-ne1"), http.MethodGet, server.URL+tt.path, nil)
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+			if got := <-paths; got != tt.expected {
+				t.Errorf("request sent to %s, want %s", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTransportRejectsGlobalRequestForRegion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("request sent to %s", r.URL.Path)
+	}))
+	defer server.Close()
+
+	transport := &Transport{Endpoint: Endpoint{Region: "br-se1", Env: EnvProd, ServerURL: server.URL + "/api"}}
+	client := &http.Client{Transport: transport}
+
+	for _, path := range []string{"/profile/v0/ssh-keys", "/api/profile/v0/ssh-keys", "/api/br-se1x/compute"} {
+		req, _ := http.NewRequestWithContext(WithRegion(context.Background(), "br-ne1"), http.MethodGet, server.URL+path, nil)
+		if resp, err := client.Do(req); err == nil {
+			resp.Body.Close()
+			t.Errorf("%s: expected an error for a URL outside the regional API", path)
+		}
+	}
+}
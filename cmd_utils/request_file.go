@@ -0,0 +1,162 @@
+package cmdutils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/magaluCloud/mgccli/beautiful"
+	flags "github.com/magaluCloud/mgccli/cobra_utils/flags"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FromFileFlag         = "from-file"
+	GenerateSkeletonFlag = "generate-skeleton"
+
+	maxSkeletonDepth = 8
+)
+
+// AddRequestFileFlags adiciona --from-file e --generate-skeleton a um comando
+// que envia um corpo de requisição
+func AddRequestFileFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FromFileFlag, "", "Read the request body from a JSON or YAML file (use - for stdin). Flags passed explicitly take precedence")
+	cmd.Flags().Bool(GenerateSkeletonFlag, false, "Print an empty template of the request body for use with --from-file")
+}
+
+// LoadRequestBody preenche req (ponteiro para o struct do SDK) a partir de
+// --from-file. Com --generate-skeleton imprime o modelo do corpo e retorna
+// done=true, indicando que o comando deve terminar sem chamar a API
+func LoadRequestBody(cmd *cobra.Command, req any) (done bool, err error) {
+	if skeleton, _ := cmd.Flags().GetBool(GenerateSkeletonFlag); skeleton {
+		return true, printSkeleton(reflect.TypeOf(req))
+	}
+
+	path, _ := cmd.Flags().GetString(FromFileFlag)
+	if path == "" {
+		return false, nil
+	}
+
+	var data []byte
+	if path == "-" {
+		path = "stdin"
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return false, NewUsageError(fmt.Sprintf("cannot read --%s", FromFileFlag), err.Error())
+	}
+
+	if err := flags.Decode(data, req); err != nil {
+		return false, &CliError{
+			Message: fmt.Sprintf("invalid request in %s", path),
+			Details: err.Error(),
+			Kind:    ErrorKindValidation,
+		}
+	}
+	return false, nil
+}
+
+// printSkeleton imprime o modelo do corpo: YAML comentado com os tipos dos
+// campos ou, quando a saída escolhida é JSON, um objeto JSON vazio equivalente
+func printSkeleton(t reflect.Type) error {
+	t = flags.DerefType(t)
+	if beautiful.IsJSONFormat(beautiful.DefaultFormat()) {
+		data, err := json.MarshalIndent(skeletonValue(t, 0), "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	lines := skeletonLines(t, 0)
+	fmt.Printf("# %s\n%s\n", t.String(), strings.Join(lines, "\n"))
+	return nil
+}
+
+func skeletonValue(t reflect.Type, depth int) any {
+	t = flags.DerefType(t)
+	if flags.HasCustomDecoding(t) {
+		return ""
+	}
+	switch t.Kind() {
+	case reflect.Struct:
+		obj := map[string]any{}
+		if depth < maxSkeletonDepth {
+			for name, field := range flags.JSONFields(t) {
+				obj[name] = skeletonValue(field.Type, depth+1)
+			}
+		}
+		return obj
+	case reflect.Map:
+		return map[string]any{}
+	case reflect.Slice, reflect.Array:
+		elem := flags.DerefType(t.Elem())
+		if elem.Kind() == reflect.Struct && !flags.HasCustomDecoding(elem) {
+			return []any{skeletonValue(elem, depth+1)}
+		}
+		return []any{}
+	}
+	return reflect.Zero(t).Interface()
+}
+
+// skeletonLines gera as linhas YAML de um struct, uma por campo, com o tipo
+// como comentário. Campos opcionais (ponteiros ou omitempty) são indicados
+func skeletonLines(t reflect.Type, depth int) []string {
+	fields := flags.JSONFields(t)
+	lines := []string{}
+	for _, name := range flags.SortedFieldNames(fields) {
+		field := fields[name]
+		fieldType := flags.DerefType(field.Type)
+		comment := flags.TypeName(fieldType)
+		if flags.IsOptional(field) {
+			comment += ", optional"
+		}
+
+		switch {
+		case flags.HasCustomDecoding(fieldType):
+			lines = append(lines, fmt.Sprintf("%s: \"\"  # %s", name, comment))
+		case fieldType.Kind() == reflect.Struct && depth < maxSkeletonDepth:
+			lines = append(lines, fmt.Sprintf("%s:  # %s", name, comment))
+			lines = append(lines, indentLines(skeletonLines(fieldType, depth+1), "  ", "  ")...)
+		case (fieldType.Kind() == reflect.Slice || fieldType.Kind() == reflect.Array) &&
+			flags.DerefType(fieldType.Elem()).Kind() == reflect.Struct && !flags.HasCustomDecoding(flags.DerefType(fieldType.Elem())) &&
+			depth < maxSkeletonDepth:
+			lines = append(lines, fmt.Sprintf("%s:  # %s", name, comment))
+			lines = append(lines, indentLines(skeletonLines(flags.DerefType(fieldType.Elem()), depth+1), "  - ", "    ")...)
+		default:
+			lines = append(lines, fmt.Sprintf("%s: %s  # %s", name, zeroLiteral(fieldType), comment))
+		}
+	}
+	return lines
+}
+
+func indentLines(lines []string, first string, rest string) []string {
+	for i, line := range lines {
+		if i == 0 {
+			lines[i] = first + line
+		} else {
+			lines[i] = rest + line
+		}
+	}
+	return lines
+}
+
+func zeroLiteral(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.String:
+		return `""`
+	case reflect.Bool:
+		return "false"
+	case reflect.Slice, reflect.Array:
+		return "[]"
+	case reflect.Map, reflect.Struct, reflect.Interface:
+		return "{}"
+	}
+	return "0"
+}
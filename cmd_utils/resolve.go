@@ -0,0 +1,103 @@
+package cmdutils
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// resolvableValue marca uma flag de id cujo valor pode ser o nome do
+// recurso. A troca do nome pelo id é feita por ResolveNames
+type resolvableValue struct {
+	pflag.Value
+	source CompletionSource
+}
+
+// ResolveFlag permite informar o nome do recurso na flag de id (e no
+// argumento posicional equivalente) em parent e em todos os seus subcomandos
+func ResolveFlag(parent *cobra.Command, flag string, source CompletionSource) {
+	if parent == nil {
+		return
+	}
+	for _, cmd := range commandTree(parent) {
+		if f := cmd.Flags().Lookup(flag); f != nil {
+			if _, ok := f.Value.(*resolvableValue); !ok {
+				f.Value = &resolvableValue{Value: f.Value, source: source}
+			}
+		}
+	}
+}
+
+// ResolveNames troca pelos ids os nomes informados nas flags registradas com
+// ResolveFlag e nos argumentos posicionais correspondentes. Valores que já
+// são UUIDs não consultam a API
+func ResolveNames(cmd *cobra.Command, args []string) error {
+	var err error
+	cmd.Flags().VisitAll(func(flag *pflag.Flag) {
+		value, ok := flag.Value.(*resolvableValue)
+		if !ok || err != nil {
+			return
+		}
+
+		if position := argPosition(cmd, flag.Name); position >= 0 && position < len(args) {
+			if args[position], err = value.source.resolve(cmd.Context(), args[position]); err != nil {
+				return
+			}
+		}
+		if flag.Changed {
+			var id string
+			if id, err = value.source.resolve(cmd.Context(), value.String()); err != nil {
+				return
+			}
+			err = value.Value.Set(id)
+		}
+	})
+	return err
+}
+
+// resolve retorna o id do recurso chamado name. Erra quando nenhum recurso
+// tem o nome ou quando mais de um tem, listando os candidatos
+func (s CompletionSource) resolve(ctx context.Context, name string) (string, error) {
+	if name == "" || uuidPattern.MatchString(name) {
+		return name, nil
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	list, err := s.list(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	candidates := []string{}
+	for _, obj := range list {
+		id := fieldString(obj, s.Field)
+		if id == name {
+			return id, nil
+		}
+		if fieldString(obj, "name") == name {
+			candidates = append(candidates, id)
+		}
+	}
+
+	switch len(candidates) {
+	case 0:
+		return "", &CliError{
+			Message: fmt.Sprintf("no %s found with name or id %q", s.Resource, name),
+			Kind:    ErrorKindNotFound,
+		}
+	case 1:
+		return candidates[0], nil
+	}
+	return "", NewUsageError(
+		fmt.Sprintf("name %q matches %d %s", name, len(candidates), s.Resource),
+		fmt.Sprintf("use one of the ids instead: %s", strings.Join(candidates, ", ")),
+	)
+}
@@ -0,0 +1,194 @@
+package cmdutils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	WaitFlag         = "wait"
+	WaitTimeoutFlag  = "wait-timeout"
+	WaitStatusFlag   = "status"
+	defaultWaitLimit = 15 * time.Minute
+)
+
+// StatusFunc consulta o recurso e retorna seus status atuais (ex: status e state)
+type StatusFunc func(ctx context.Context) ([]string, error)
+
+// WaitOptions configura a espera por um status
+type WaitOptions struct {
+	Resource    string
+	Target      []string
+	Timeout     time.Duration
+	Interval    time.Duration
+	MaxInterval time.Duration
+	// Failed identifica status terminais de erro. Padrão: status contendo "error"
+	Failed func(status string) bool
+	// Applied é usado em operações sobre recursos que já estão no status alvo
+	// (ex: resize de um volume "available"). O alvo só é aceito depois de um
+	// status transitório ou quando Applied confirma que a alteração aparece
+	Applied func(ctx context.Context) (bool, error)
+}
+
+var ErrWaitTimeout = errors.New("timed out waiting for resource")
+
+// AddWaitFlags adiciona --wait e --wait-timeout a um comando que altera recursos
+func AddWaitFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(WaitFlag, false, "Wait until the resource reaches the expected state")
+	cmd.Flags().Duration(WaitTimeoutFlag, defaultWaitLimit, "Maximum time to wait when --wait is used")
+}
+
+// ShouldWait informa se o usuário pediu --wait
+func ShouldWait(cmd *cobra.Command) bool {
+	wait, _ := cmd.Flags().GetBool(WaitFlag)
+	return wait
+}
+
+// NewWaitOptions monta as opções de espera a partir das flags do comando
+func NewWaitOptions(cmd *cobra.Command, resource string, target ...string) WaitOptions {
+	timeout, err := cmd.Flags().GetDuration(WaitTimeoutFlag)
+	if err != nil || timeout <= 0 {
+		timeout = defaultWaitLimit
+	}
+	return WaitOptions{
+		Resource: resource,
+		Target:   target,
+		Timeout:  timeout,
+	}
+}
+
+// WaitForStatus consulta o recurso com backoff exponencial até que um dos
+// status retornados seja um dos alvos, um status de erro apareça, o tempo
+// limite acabe ou o usuário interrompa com Ctrl-C. Com opts.Applied, o alvo
+// só vale depois que a operação começou (veja WaitOptions)
+func WaitForStatus(ctx context.Context, opts WaitOptions, get StatusFunc) error {
+	if opts.Interval <= 0 {
+		opts.Interval = 2 * time.Second
+	}
+	if opts.MaxInterval <= 0 {
+		opts.MaxInterval = 30 * time.Second
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = defaultWaitLimit
+	}
+	if opts.Failed == nil {
+		opts.Failed = func(status string) bool {
+			return strings.Contains(strings.ToLower(status), "error")
+		}
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	defer cancel()
+
+	target := strings.Join(opts.Target, "|")
+	interval := opts.Interval
+	last := ""
+	transitioned := opts.Applied == nil
+	for {
+		statuses, err := get(ctx)
+		if err != nil && ctx.Err() == nil {
+			return err
+		}
+
+		if err == nil {
+			current := strings.Join(statuses, "/")
+			if current != last {
+				fmt.Fprintf(os.Stderr, "Waiting for %s to be %s (current: %s)\n", opts.Resource, target, current)
+				last = current
+			}
+
+			if reachedTarget(statuses, opts.Target) {
+				if transitioned {
+					return nil
+				}
+				applied, err := opts.Applied(ctx)
+				if err != nil && ctx.Err() == nil {
+					return err
+				}
+				if applied {
+					return nil
+				}
+			} else {
+				transitioned = true
+			}
+			for _, status := range statuses {
+				if opts.Failed(status) {
+					return NewCliErrorWithDetails(
+						fmt.Sprintf("%s reached failure status %q", opts.Resource, status),
+						fmt.Sprintf("expected %s", target),
+					)
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return &CliError{
+					Message: fmt.Sprintf("%s: %s after %s", ErrWaitTimeout.Error(), opts.Resource, opts.Timeout),
+					Details: fmt.Sprintf("expected %s, last status: %s", target, last),
+					Kind:    ErrorKindTimeout,
+				}
+			}
+			return NewCliError(fmt.Sprintf("stopped waiting for %s: %v", opts.Resource, ctx.Err()))
+		case <-time.After(interval):
+		}
+
+		interval = interval * 3 / 2
+		if interval > opts.MaxInterval {
+			interval = opts.MaxInterval
+		}
+	}
+}
+
+func reachedTarget(statuses []string, target []string) bool {
+	for _, status := range statuses {
+		for _, expected := range target {
+			if strings.EqualFold(status, expected) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// NewWaitCmd cria o subcomando "wait [id] --status <status>" de um recurso
+func NewWaitCmd(ctx context.Context, resource string, defaultStatus string, status func(ctx context.Context, id string) ([]string, error)) *cobra.Command {
+	var id string
+	var target []string
+
+	cmd := &cobra.Command{
+		Use:   "wait [id]",
+		Short: fmt.Sprintf("Wait for a %s to reach a status", resource),
+		Long:  fmt.Sprintf("Poll the %s until it reaches one of the given statuses, fails or the timeout expires", resource),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				cmd.Flags().Set("id", args[0])
+			}
+			if id == "" {
+				return NewMissingArgError("id")
+			}
+
+			opts := NewWaitOptions(cmd, fmt.Sprintf("%s %s", resource, id), target...)
+			return WaitForStatus(ctx, opts, func(ctx context.Context) ([]string, error) {
+				return status(ctx, id)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&id, "id", "", " (required)")
+	cmd.Flags().StringSliceVar(&target, WaitStatusFlag, []string{defaultStatus}, "Statuses that end the wait")
+	cmd.Flags().Duration(WaitTimeoutFlag, defaultWaitLimit, "Maximum time to wait")
+
+	return cmd
+}
@@ -0,0 +1,122 @@
+package cmdutils
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// statusSequence retorna os status informados, um por consulta, repetindo o
+// último quando a sequência acaba
+func statusSequence(statuses ...string) (StatusFunc, *int) {
+	calls := 0
+	return func(ctx context.Context) ([]string, error) {
+		status := statuses[min(calls, len(statuses)-1)]
+		calls++
+		return []string{status}, nil
+	}, &calls
+}
+
+func testWaitOptions(target ...string) WaitOptions {
+	return WaitOptions{
+		Resource:    "volume v1",
+		Target:      target,
+		Timeout:     time.Second,
+		Interval:    time.Millisecond,
+		MaxInterval: time.Millisecond,
+	}
+}
+
+func TestWaitForStatusImmediateMatch(t *testing.T) {
+	get, calls := statusSequence("AVAILABLE")
+	if err := WaitForStatus(context.Background(), testWaitOptions("available"), get); err != nil {
+		t.Fatal(err)
+	}
+	if *calls != 1 {
+		t.Errorf("got %d calls, want 1", *calls)
+	}
+}
+
+func TestWaitForStatusPolling(t *testing.T) {
+	get, calls := statusSequence("creating", "creating", "available")
+	if err := WaitForStatus(context.Background(), testWaitOptions("available"), get); err != nil {
+		t.Fatal(err)
+	}
+	if *calls != 3 {
+		t.Errorf("got %d calls, want 3", *calls)
+	}
+}
+
+func TestWaitForStatusErrorStatus(t *testing.T) {
+	get, _ := statusSequence("creating", "error")
+	err := WaitForStatus(context.Background(), testWaitOptions("available"), get)
+
+	var cliErr *CliError
+	if !errors.As(err, &cliErr) {
+		t.Fatalf("got %v, want a CliError", err)
+	}
+	if cliErr.Message != `volume v1 reached failure status "error"` {
+		t.Errorf("unexpected message %q", cliErr.Message)
+	}
+}
+
+func TestWaitForStatusTimeout(t *testing.T) {
+	get, _ := statusSequence("creating")
+	opts := testWaitOptions("available")
+	opts.Timeout = 20 * time.Millisecond
+	err := WaitForStatus(context.Background(), opts, get)
+
+	var cliErr *CliError
+	if !errors.As(err, &cliErr) || cliErr.Kind != ErrorKindTimeout {
+		t.Fatalf("got %v, want a timeout error", err)
+	}
+}
+
+func TestWaitForStatusGetError(t *testing.T) {
+	expected := errors.New("not found")
+	err := WaitForStatus(context.Background(), testWaitOptions("available"), func(ctx context.Context) ([]string, error) {
+		return nil, expected
+	})
+	if !errors.Is(err, expected) {
+		t.Fatalf("got %v, want %v", err, expected)
+	}
+}
+
+func TestWaitForStatusApplied(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []string
+		applied  func(calls int) bool
+		calls    int
+	}{
+		{
+			name:     "waits for a transitional status",
+			statuses: []string{"available", "available", "extending", "available"},
+			applied:  func(int) bool { return false },
+			calls:    4,
+		},
+		{
+			name:     "accepts the target once the change is applied",
+			statuses: []string{"available"},
+			applied:  func(calls int) bool { return calls >= 2 },
+			calls:    2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			get, calls := statusSequence(tt.statuses...)
+			opts := testWaitOptions("available")
+			opts.Applied = func(ctx context.Context) (bool, error) {
+				return tt.applied(*calls), nil
+			}
+			if err := WaitForStatus(context.Background(), opts, get); err != nil {
+				t.Fatal(err)
+			}
+			if *calls != tt.calls {
+				t.Errorf("got %d calls, want %d", *calls, tt.calls)
+			}
+		})
+	}
+}
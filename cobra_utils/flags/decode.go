@@ -0,0 +1,287 @@
+package cobrautils
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// FilePrefix indica que o valor da flag deve ser lido de um arquivo (@-: stdin)
+	FilePrefix = "@"
+
+	maxDescribeDepth = 4
+)
+
+var (
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// ReadValue retorna o conteúdo de um valor de flag. Valores no formato
+// @caminho são lidos do arquivo e @- é lido de stdin
+func ReadValue(val string) ([]byte, error) {
+	path, ok := strings.CutPrefix(val, FilePrefix)
+	if !ok {
+		return []byte(val), nil
+	}
+	if path == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(path)
+}
+
+// Decode decodifica JSON ou YAML em target, rejeitando campos desconhecidos
+// e tipos incompatíveis com mensagens que indicam o caminho do campo
+// (ex: listeners[0].port)
+func Decode(data []byte, target any) error {
+	var generic any
+	if err := yaml.Unmarshal(data, &generic); err != nil {
+		return err
+	}
+	if generic == nil {
+		return nil
+	}
+
+	if err := checkValue(generic, reflect.TypeOf(target), ""); err != nil {
+		return err
+	}
+
+	jsonData, err := json.Marshal(generic)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(jsonData, target)
+}
+
+func checkValue(value any, t reflect.Type, path string) error {
+	t = DerefType(t)
+	if value == nil || HasCustomDecoding(t) {
+		return nil
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return typeError(path, "object", value)
+		}
+		fields := JSONFields(t)
+		for key, item := range obj {
+			field, ok := fields[key]
+			if !ok {
+				return fmt.Errorf("%s: unknown field", fieldPath(path, key))
+			}
+			if err := checkValue(item, field.Type, fieldPath(path, key)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return typeError(path, "object", value)
+		}
+		for key, item := range obj {
+			if err := checkValue(item, t.Elem(), fieldPath(path, key)); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		list, ok := value.([]any)
+		if !ok {
+			return typeError(path, "list", value)
+		}
+		for i, item := range list {
+			if err := checkValue(item, t.Elem(), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	case reflect.String:
+		if _, ok := value.(string); !ok {
+			return typeError(path, "string", value)
+		}
+	case reflect.Bool:
+		if _, ok := value.(bool); !ok {
+			return typeError(path, "boolean", value)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		switch v := value.(type) {
+		case int:
+		case float64:
+			if v != math.Trunc(v) {
+				return typeError(path, "integer", value)
+			}
+		default:
+			return typeError(path, "integer", value)
+		}
+	case reflect.Float32, reflect.Float64:
+		switch value.(type) {
+		case int, float64:
+		default:
+			return typeError(path, "number", value)
+		}
+	}
+	return nil
+}
+
+func typeError(path string, expected string, value any) error {
+	if path == "" {
+		path = "(root)"
+	}
+	return fmt.Errorf("%s: expected %s, got %s", path, expected, describeValue(value))
+}
+
+func describeValue(value any) string {
+	switch v := value.(type) {
+	case map[string]any:
+		return "object"
+	case []any:
+		return "list"
+	case string:
+		return fmt.Sprintf("string %q", v)
+	case bool:
+		return "boolean"
+	case int, float64:
+		return fmt.Sprintf("number %v", v)
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+func fieldPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// JSONFields mapeia o nome JSON de cada campo exportado, incluindo os campos
+// de structs embutidos
+func JSONFields(t reflect.Type) map[string]reflect.StructField {
+	fields := map[string]reflect.StructField{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if field.Anonymous && name == "" && DerefType(field.Type).Kind() == reflect.Struct {
+			for embeddedName, embedded := range JSONFields(DerefType(field.Type)) {
+				fields[embeddedName] = embedded
+			}
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field
+	}
+	return fields
+}
+
+// SortedFieldNames retorna os nomes JSON dos campos em ordem alfabética
+func SortedFieldNames(fields map[string]reflect.StructField) []string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// IsOptional informa se o campo pode ser omitido (ponteiro ou omitempty)
+func IsOptional(field reflect.StructField) bool {
+	return field.Type.Kind() == reflect.Pointer || strings.Contains(field.Tag.Get("json"), "omitempty")
+}
+
+// HasCustomDecoding informa se o tipo tem decodificação própria (ex: time.Time)
+func HasCustomDecoding(t reflect.Type) bool {
+	pointer := reflect.PointerTo(t)
+	return t.Implements(jsonUnmarshalerType) || pointer.Implements(jsonUnmarshalerType) ||
+		t.Implements(textUnmarshalerType) || pointer.Implements(textUnmarshalerType)
+}
+
+// DerefType remove os níveis de ponteiro do tipo
+func DerefType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+// TypeName descreve o tipo como aparece no JSON (string, integer, list of ...)
+func TypeName(t reflect.Type) string {
+	if HasCustomDecoding(t) {
+		return t.Name()
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice, reflect.Array:
+		return "list of " + TypeName(DerefType(t.Elem()))
+	case reflect.Map:
+		return "map of " + TypeName(DerefType(t.Elem()))
+	case reflect.Struct:
+		return "object"
+	}
+	return "any"
+}
+
+// describeType gera a descrição da estrutura de t exibida no --help das
+// flags JSON: um campo por linha com tipo e obrigatoriedade
+func describeType(t reflect.Type) string {
+	t = DerefType(t)
+	lines := []string{fmt.Sprintf("Structure (%s):", TypeName(t))}
+	if nested := structElem(t); nested != nil {
+		lines = append(lines, describeFields(nested, "  ", 0)...)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func describeFields(t reflect.Type, indent string, depth int) []string {
+	fields := JSONFields(t)
+	lines := []string{}
+	for _, name := range SortedFieldNames(fields) {
+		field := fields[name]
+		fieldType := DerefType(field.Type)
+		requirement := "required"
+		if IsOptional(field) {
+			requirement = "optional"
+		}
+		lines = append(lines, fmt.Sprintf("%s%s: %s (%s)", indent, name, TypeName(fieldType), requirement))
+		if nested := structElem(fieldType); nested != nil && depth < maxDescribeDepth {
+			lines = append(lines, describeFields(nested, indent+"  ", depth+1)...)
+		}
+	}
+	return lines
+}
+
+// structElem retorna o struct descrito por t, seja o próprio tipo ou o
+// elemento de uma lista, ou nil quando não há campos a descrever
+func structElem(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = DerefType(t.Elem())
+	}
+	if t.Kind() == reflect.Struct && !HasCustomDecoding(t) {
+		return t
+	}
+	return nil
+}
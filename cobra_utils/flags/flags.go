@@ -107,14 +107,14 @@ func NewStrSliceP(cmd *cobra.Command, name string, shorthand string, defaultValu
 }
 
 func NewStrMap(cmd *cobra.Command, name string, defaultValue map[string]string, usage string) *StrMapFlag {
-	var value *map[string]string = new(map[string]string)
-	cmd.Flags().StringToStringVar(value, name, defaultValue, usage)
+	var value *map[string]string = &defaultValue
+	cmd.Flags().Var(&strMapValue{value: value}, name, strMapUsage(usage))
 	return &StrMapFlag{baseFlag: baseFlag{cmd, name}, Value: value}
 }
 
 func NewStrMapP(cmd *cobra.Command, name string, shorthand string, defaultValue map[string]string, usage string) *StrMapFlag {
-	var value *map[string]string = new(map[string]string)
-	cmd.Flags().StringToStringVarP(value, name, shorthand, defaultValue, usage)
+	var value *map[string]string = &defaultValue
+	cmd.Flags().VarP(&strMapValue{value: value}, name, shorthand, strMapUsage(usage))
 	return &StrMapFlag{baseFlag: baseFlag{cmd, name}, Value: value}
 }
 
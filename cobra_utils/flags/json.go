@@ -3,30 +3,42 @@ package cobrautils
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"strings"
 
 	"github.com/spf13/cobra"
 )
 
-// JSONValue é um tipo genérico para parsear flags JSON usando pflag/cobra
+// JSONValue é um tipo genérico para parsear flags JSON usando pflag/cobra.
+// Aceita JSON ou YAML, inline ou lido de arquivo com @caminho (@- para stdin)
 // Exemplo de uso:
 //
-//	var myFlag cobrautils.JSONValue[MyType]
-//	flags.Var(&myFlag, "myflag", "Some JSON input")
+//	myFlag := cobrautils.NewJSONValue[MyType](cmd, "myflag", "Some JSON input")
 type JSONValue[T any] struct {
 	baseFlag
 	Value *T
 }
 
-// Set faz o parse do JSON recebido na flag para o tipo T
+// Set faz o parse do valor recebido na flag para o tipo T, rejeitando campos
+// desconhecidos
 func (j *JSONValue[T]) Set(val string) error {
-	if err := json.Unmarshal([]byte(val), j.Value); err != nil {
-		return fmt.Errorf("invalid JSON for flag: %w", err)
+	data, err := ReadValue(val)
+	if err != nil {
+		return fmt.Errorf("cannot read value: %w", err)
+	}
+	value := new(T)
+	if err := Decode(data, value); err != nil {
+		return fmt.Errorf("invalid JSON/YAML for flag: %w", err)
 	}
+	*j.Value = *value
 	return nil
 }
 
 // String serializa o valor atual para JSON
 func (j *JSONValue[T]) String() string {
+	if j.Value == nil {
+		return "{}"
+	}
 	b, err := json.Marshal(*j.Value)
 	if err != nil {
 		return "{}"
@@ -40,13 +52,25 @@ func (j *JSONValue[T]) Type() string {
 }
 
 func NewJSONValue[T any](cmd *cobra.Command, name string, usage string) *JSONValue[T] {
-	var value *JSONValue[T] = new(JSONValue[T])
-	cmd.Flags().Var(value, name, usage)
-	return &JSONValue[T]{baseFlag: baseFlag{cmd, name}, Value: value.Value}
+	value := &JSONValue[T]{baseFlag: baseFlag{cmd, name}, Value: new(T)}
+	cmd.Flags().Var(value, name, jsonUsage[T](usage))
+	return value
 }
 
 func NewJSONValueP[T any](cmd *cobra.Command, name string, shorthand string, usage string) *JSONValue[T] {
-	var value *JSONValue[T] = new(JSONValue[T])
-	cmd.Flags().VarP(value, name, shorthand, usage)
-	return &JSONValue[T]{baseFlag: baseFlag{cmd, name}, Value: value.Value}
+	value := &JSONValue[T]{baseFlag: baseFlag{cmd, name}, Value: new(T)}
+	cmd.Flags().VarP(value, name, shorthand, jsonUsage[T](usage))
+	return value
+}
+
+// jsonUsage completa a descrição da flag com os formatos aceitos e a
+// estrutura esperada de T
+func jsonUsage[T any](usage string) string {
+	formats := "JSON or YAML, inline or from a file with @path (@- for stdin)"
+	if usage == "" {
+		usage = formats
+	} else {
+		usage = strings.TrimSuffix(usage, ".") + ". " + formats
+	}
+	return usage + "\n" + describeType(reflect.TypeOf((*T)(nil)).Elem())
 }
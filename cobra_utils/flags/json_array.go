@@ -7,11 +7,8 @@ import (
 	"github.com/spf13/cobra"
 )
 
-// JSONValue é um tipo genérico para parsear flags JSON usando pflag/cobra
-// Exemplo de uso:
-//
-//	var myFlag cobrautils.JSONValue[MyType]
-//	flags.Var(&myFlag, "myflag", "Some JSON input")
+// JSONArrayValue é a versão de JSONValue para listas de T. Aceita os mesmos
+// formatos: JSON ou YAML, inline ou lido de arquivo com @caminho
 type JSONArrayValue[T any] struct {
 	baseFlag
 	Value *[]T
@@ -21,17 +18,26 @@ func (j *JSONArrayValue[T]) IsChanged() bool {
 	return j.cmd.Flags().Changed(j.name)
 }
 
-// Set faz o parse do JSON recebido na flag para o tipo T
+// Set faz o parse do valor recebido na flag para uma lista de T, rejeitando
+// campos desconhecidos
 func (j *JSONArrayValue[T]) Set(val string) error {
-	if err := json.Unmarshal([]byte(val), j.Value); err != nil {
-		return fmt.Errorf("invalid JSON for flag: %w", err)
+	data, err := ReadValue(val)
+	if err != nil {
+		return fmt.Errorf("cannot read value: %w", err)
 	}
+	value := new([]T)
+	if err := Decode(data, value); err != nil {
+		return fmt.Errorf("invalid JSON/YAML for flag: %w", err)
+	}
+	*j.Value = *value
 	return nil
 }
 
 // String serializa o valor atual para JSON
 func (j *JSONArrayValue[T]) String() string {
-	j.Value = new([]T)
+	if j.Value == nil || *j.Value == nil {
+		return "[]"
+	}
 	b, err := json.Marshal(*j.Value)
 	if err != nil || len(b) == 0 {
 		return "[]"
@@ -45,13 +51,13 @@ func (j *JSONArrayValue[T]) Type() string {
 }
 
 func NewJSONArrayValue[T any](cmd *cobra.Command, name string, usage string) *JSONArrayValue[T] {
-	var value *JSONArrayValue[T] = new(JSONArrayValue[T])
-	cmd.Flags().Var(value, name, usage)
-	return &JSONArrayValue[T]{baseFlag: baseFlag{cmd, name}, Value: value.Value}
+	value := &JSONArrayValue[T]{baseFlag: baseFlag{cmd, name}, Value: new([]T)}
+	cmd.Flags().Var(value, name, jsonUsage[[]T](usage))
+	return value
 }
 
 func NewJSONArrayValueP[T any](cmd *cobra.Command, name string, shorthand string, usage string) *JSONArrayValue[T] {
-	var value *JSONArrayValue[T] = new(JSONArrayValue[T])
-	cmd.Flags().VarP(value, name, shorthand, usage)
-	return &JSONArrayValue[T]{baseFlag: baseFlag{cmd, name}, Value: value.Value}
+	value := &JSONArrayValue[T]{baseFlag: baseFlag{cmd, name}, Value: new([]T)}
+	cmd.Flags().VarP(value, name, shorthand, jsonUsage[[]T](usage))
+	return value
 }
@@ -0,0 +1,105 @@
+package cobrautils
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// strMapValue aceita o formato chave=valor do pflag (a=1,b=2) e também um
+// objeto JSON ou YAML, inline ou lido de arquivo com @caminho (@- para stdin)
+type strMapValue struct {
+	value   *map[string]string
+	changed bool
+}
+
+// Set mescla os pares recebidos no mapa. Como no pflag, o primeiro uso da
+// flag substitui o valor padrão e os seguintes acrescentam chaves
+func (s *strMapValue) Set(val string) error {
+	values, err := parseStrMap(val)
+	if err != nil {
+		return err
+	}
+	if !s.changed {
+		*s.value = values
+		s.changed = true
+		return nil
+	}
+	for key, value := range values {
+		(*s.value)[key] = value
+	}
+	return nil
+}
+
+// String serializa o mapa no formato chave=valor
+func (s *strMapValue) String() string {
+	keys := make([]string, 0, len(*s.value))
+	for key := range *s.value {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, key+"="+(*s.value)[key])
+	}
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write(pairs)
+	w.Flush()
+	return "[" + strings.TrimSpace(buf.String()) + "]"
+}
+
+// Type retorna o nome do tipo para pflag
+func (s *strMapValue) Type() string {
+	return "stringToString"
+}
+
+func parseStrMap(val string) (map[string]string, error) {
+	if strings.HasPrefix(val, FilePrefix) || strings.HasPrefix(strings.TrimSpace(val), "{") {
+		data, err := ReadValue(val)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read value: %w", err)
+		}
+		values := map[string]string{}
+		if err := Decode(data, &values); err != nil {
+			return nil, fmt.Errorf("invalid JSON/YAML for flag: %w", err)
+		}
+		return values, nil
+	}
+
+	var pairs []string
+	switch strings.Count(val, "=") {
+	case 0:
+		return nil, fmt.Errorf("%s must be formatted as key=value", val)
+	case 1:
+		pairs = []string{strings.Trim(val, `"`)}
+	default:
+		var err error
+		pairs, err = csv.NewReader(strings.NewReader(val)).Read()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	values := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("%s must be formatted as key=value", pair)
+		}
+		values[key] = value
+	}
+	return values, nil
+}
+
+// strMapUsage completa a descrição da flag com os formatos aceitos
+func strMapUsage(usage string) string {
+	formats := "key=value pairs, or a JSON/YAML object inline or from a file with @path (@- for stdin)"
+	if usage == "" {
+		return formats
+	}
+	return strings.TrimSuffix(usage, ".") + ". Accepts " + formats
+}
@@ -10,10 +10,13 @@ module github.com/magaluCloud/mgccli
 go 1.25.3
 
 require (
+	filippo.io/age v1.2.1
 	github.com/MagaluCloud/mgc-sdk-go v1.0.0
 	github.com/charmbracelet/huh v0.8.0
 	github.com/fatih/color v1.16.0
+	github.com/jmespath/go-jmespath v0.4.0
 	github.com/pkg/browser v0.0.0-20240102092130-5ac0b6a4141c
+	github.com/skip2/go-qrcode v0.0.0-20200617195104-da1b6568686e
 	github.com/spf13/cobra v1.9.1
 	github.com/spf13/pflag v1.0.6
 )
@@ -41,6 +44,7 @@ require (
 	github.com/muesli/termenv v0.16.0 // indirect
 	github.com/rivo/uniseg v0.4.7 // indirect
 	github.com/xo/terminfo v0.0.0-20220910002029-abceb7e1c41e // indirect
+	golang.org/x/crypto v0.39.0 // indirect
 	golang.org/x/sync v0.15.0 // indirect
 	golang.org/x/text v0.26.0 // indirect
 )
@@ -49,7 +53,7 @@ require (
 	github.com/golang-jwt/jwt/v5 v5.3.0
 	github.com/inconshreveable/mousetrap v1.1.0 // indirect
 	github.com/mattn/go-colorable v0.1.13 // indirect
-	github.com/mattn/go-isatty v0.0.20 // indirect
-	golang.org/x/sys v0.33.0 // indirect
+	github.com/mattn/go-isatty v0.0.20
+	golang.org/x/sys v0.33.0
 	gopkg.in/yaml.v3 v3.0.1
 )
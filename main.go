@@ -60,9 +60,9 @@ func main() {
 	version := fmt.Sprintf("%s (%s)", version, manager.GetLanguage())
 
 	rootCmd := cmd.RootCmd(ctx, version, args)
-	err := rootCmd.Execute()
+	executedCmd, err := rootCmd.ExecuteC()
 	if err != nil {
-		os.Exit(1)
+		os.Exit(cmdutils.ExitCode(executedCmd, err))
 	}
 }
 